@@ -84,6 +84,28 @@ type AuthOptions struct {
 
 	// Scope determines the scoping of the authentication request.
 	Scope *AuthScope `json:"-"`
+
+	// ApplicationCredentialID or ApplicationCredentialName, together with
+	// ApplicationCredentialSecret, authenticate with an application credential
+	// in Identity V3. A name must be accompanied by either UserID or Username
+	// and DomainID or DomainName. Since an application credential is bound to
+	// a project, Scope and the tenant fields are ignored.
+	ApplicationCredentialID     string `json:"-"`
+	ApplicationCredentialName   string `json:"-"`
+	ApplicationCredentialSecret string `json:"-"`
+
+	// Passcode is a time-based one-time password used to authenticate with the
+	// "totp" method in Identity V3. It identifies the user the same way
+	// Password does, and both may be provided to satisfy a multi-factor
+	// authentication rule with a single request. Since a passcode is only
+	// valid for a short time, reauthentication is unlikely to succeed with it.
+	Passcode string `json:"-"`
+
+	// Receipt is the ID of an authentication receipt returned by Identity V3
+	// when some, but not all, of the authentication methods required for the
+	// user were provided. Set it to continue authentication with the missing
+	// methods only. See tokens.ErrAuthReceiptRequired.
+	Receipt string `json:"-"`
 }
 
 // AuthScope allows a created token to be limited to a specific domain or project.
@@ -92,6 +114,11 @@ type AuthScope struct {
 	ProjectName string
 	DomainID    string
 	DomainName  string
+
+	// System scopes the token to the whole deployment, for operations on
+	// system-wide resources such as services and endpoints. It must be
+	// supplied alone.
+	System bool
 }
 
 // ToTokenV2CreateMap allows AuthOptions to satisfy the AuthOptionsBuilder
@@ -142,7 +169,8 @@ func (opts *AuthOptions) ToTokenV3CreateMap(scope map[string]interface{}) (map[s
 	type userReq struct {
 		ID       *string    `json:"id,omitempty"`
 		Name     *string    `json:"name,omitempty"`
-		Password string     `json:"password"`
+		Password *string    `json:"password,omitempty"`
+		Passcode *string    `json:"passcode,omitempty"`
 		Domain   *domainReq `json:"domain,omitempty"`
 	}
 
@@ -150,14 +178,27 @@ func (opts *AuthOptions) ToTokenV3CreateMap(scope map[string]interface{}) (map[s
 		User userReq `json:"user"`
 	}
 
+	type totpReq struct {
+		User userReq `json:"user"`
+	}
+
 	type tokenReq struct {
 		ID string `json:"id"`
 	}
 
+	type applicationCredentialReq struct {
+		ID     *string  `json:"id,omitempty"`
+		Name   *string  `json:"name,omitempty"`
+		User   *userReq `json:"user,omitempty"`
+		Secret *string  `json:"secret,omitempty"`
+	}
+
 	type identityReq struct {
-		Methods  []string     `json:"methods"`
-		Password *passwordReq `json:"password,omitempty"`
-		Token    *tokenReq    `json:"token,omitempty"`
+		Methods               []string                  `json:"methods"`
+		Password              *passwordReq              `json:"password,omitempty"`
+		Token                 *tokenReq                 `json:"token,omitempty"`
+		TOTP                  *totpReq                  `json:"totp,omitempty"`
+		ApplicationCredential *applicationCredentialReq `json:"application_credential,omitempty"`
 	}
 
 	type authReq struct {
@@ -172,7 +213,7 @@ func (opts *AuthOptions) ToTokenV3CreateMap(scope map[string]interface{}) (map[s
 	// if insufficient or incompatible information is present.
 	var req request
 
-	if opts.Password == "" {
+	if opts.Password == "" && opts.Passcode == "" {
 		if opts.TokenID != "" {
 			// Because we aren't using password authentication, it's an error to also provide any of the user-based authentication
 			// parameters.
@@ -194,13 +235,71 @@ func (opts *AuthOptions) ToTokenV3CreateMap(scope map[string]interface{}) (map[s
 			req.Auth.Identity.Token = &tokenReq{
 				ID: opts.TokenID,
 			}
+		} else if opts.ApplicationCredentialID != "" {
+			// Configure the request for ApplicationCredentialID authentication.
+			// There are three kinds of possible application_credential requests
+			// 1. application_credential id + secret
+			// 2. application_credential name + secret + user_id
+			// 3. application_credential name + secret + username + domain_id / domain_name
+			if opts.ApplicationCredentialSecret == "" {
+				return nil, ErrAppCredMissingSecret{}
+			}
+			req.Auth.Identity.Methods = []string{"application_credential"}
+			req.Auth.Identity.ApplicationCredential = &applicationCredentialReq{
+				ID:     &opts.ApplicationCredentialID,
+				Secret: &opts.ApplicationCredentialSecret,
+			}
+		} else if opts.ApplicationCredentialName != "" {
+			if opts.ApplicationCredentialSecret == "" {
+				return nil, ErrAppCredMissingSecret{}
+			}
+
+			var userRequest *userReq
+
+			if opts.UserID != "" {
+				// UserID could be used without the domain information
+				userRequest = &userReq{
+					ID: &opts.UserID,
+				}
+			}
+
+			if userRequest == nil && opts.Username == "" {
+				// Make sure that Username or UserID are provided
+				return nil, ErrUsernameOrUserID{}
+			}
+
+			if userRequest == nil && opts.DomainID != "" {
+				userRequest = &userReq{
+					Name:   &opts.Username,
+					Domain: &domainReq{ID: &opts.DomainID},
+				}
+			}
+
+			if userRequest == nil && opts.DomainName != "" {
+				userRequest = &userReq{
+					Name:   &opts.Username,
+					Domain: &domainReq{Name: &opts.DomainName},
+				}
+			}
+
+			// Make sure that DomainID or DomainName are provided among Username
+			if userRequest == nil {
+				return nil, ErrDomainIDOrDomainName{}
+			}
+
+			req.Auth.Identity.Methods = []string{"application_credential"}
+			req.Auth.Identity.ApplicationCredential = &applicationCredentialReq{
+				Name:   &opts.ApplicationCredentialName,
+				User:   userRequest,
+				Secret: &opts.ApplicationCredentialSecret,
+			}
 		} else {
 			// If no password or token ID are available, authentication can't continue.
 			return nil, ErrMissingPassword{}
 		}
 	} else {
-		// Password authentication.
-		req.Auth.Identity.Methods = []string{"password"}
+		// Password and TOTP authentication, which identify the user the same way.
+		var user userReq
 
 		// At least one of Username and UserID must be specified.
 		if opts.Username == "" && opts.UserID == "" {
@@ -223,24 +322,18 @@ func (opts *AuthOptions) ToTokenV3CreateMap(scope map[string]interface{}) (map[s
 					return nil, ErrDomainIDOrDomainName{}
 				}
 
-				// Configure the request for Username and Password authentication with a DomainID.
-				req.Auth.Identity.Password = &passwordReq{
-					User: userReq{
-						Name:     &opts.Username,
-						Password: opts.Password,
-						Domain:   &domainReq{ID: &opts.DomainID},
-					},
+				// Configure the request for Username authentication with a DomainID.
+				user = userReq{
+					Name:   &opts.Username,
+					Domain: &domainReq{ID: &opts.DomainID},
 				}
 			}
 
 			if opts.DomainName != "" {
-				// Configure the request for Username and Password authentication with a DomainName.
-				req.Auth.Identity.Password = &passwordReq{
-					User: userReq{
-						Name:     &opts.Username,
-						Password: opts.Password,
-						Domain:   &domainReq{Name: &opts.DomainName},
-					},
+				// Configure the request for Username authentication with a DomainName.
+				user = userReq{
+					Name:   &opts.Username,
+					Domain: &domainReq{Name: &opts.DomainName},
 				}
 			}
 		}
@@ -254,10 +347,22 @@ func (opts *AuthOptions) ToTokenV3CreateMap(scope map[string]interface{}) (map[s
 				return nil, ErrDomainNameWithUserID{}
 			}
 
-			// Configure the request for UserID and Password authentication.
-			req.Auth.Identity.Password = &passwordReq{
-				User: userReq{ID: &opts.UserID, Password: opts.Password},
-			}
+			// Configure the request for UserID authentication.
+			user = userReq{ID: &opts.UserID}
+		}
+
+		if opts.Password != "" {
+			passwordUser := user
+			passwordUser.Password = &opts.Password
+			req.Auth.Identity.Methods = append(req.Auth.Identity.Methods, "password")
+			req.Auth.Identity.Password = &passwordReq{User: passwordUser}
+		}
+
+		if opts.Passcode != "" {
+			totpUser := user
+			totpUser.Passcode = &opts.Passcode
+			req.Auth.Identity.Methods = append(req.Auth.Identity.Methods, "totp")
+			req.Auth.Identity.TOTP = &totpReq{User: totpUser}
 		}
 	}
 
@@ -274,6 +379,12 @@ func (opts *AuthOptions) ToTokenV3CreateMap(scope map[string]interface{}) (map[s
 }
 
 func (opts *AuthOptions) ToTokenV3ScopeMap() (map[string]interface{}, error) {
+	// Application credentials are bound to the project they were created
+	// in, so Keystone rejects any explicit scope.
+	if opts.ApplicationCredentialID != "" || opts.ApplicationCredentialName != "" {
+		return nil, nil
+	}
+
 	// For backwards compatibility.
 	// If AuthOptions.Scope was not set, try to determine it.
 	// This works well for common scenarios.
@@ -290,6 +401,21 @@ func (opts *AuthOptions) ToTokenV3ScopeMap() (map[string]interface{}, error) {
 		}
 	}
 
+	if opts.Scope.System {
+		// System provided. ProjectID, ProjectName, DomainID, and DomainName may not be provided.
+		if opts.Scope.ProjectID != "" || opts.Scope.ProjectName != "" ||
+			opts.Scope.DomainID != "" || opts.Scope.DomainName != "" {
+			return nil, ErrScopeSystemAlone{}
+		}
+
+		// System
+		return map[string]interface{}{
+			"system": map[string]interface{}{
+				"all": true,
+			},
+		}, nil
+	}
+
 	if opts.Scope.ProjectName != "" {
 		// ProjectName provided: either DomainID or DomainName must also be supplied.
 		// ProjectID may not be supplied.
@@ -361,3 +487,14 @@ func (opts *AuthOptions) ToTokenV3ScopeMap() (map[string]interface{}, error) {
 func (opts AuthOptions) CanReauth() bool {
 	return opts.AllowReauth
 }
+
+// ToTokenV3HeadersMap allows AuthOptions to satisfy the
+// AuthOptionsHeadersBuilder interface in the v3 tokens package. It returns the headers to send along
+// with the authentication request.
+func (opts AuthOptions) ToTokenV3HeadersMap() (map[string]string, error) {
+	headers := make(map[string]string)
+	if opts.Receipt != "" {
+		headers["Openstack-Auth-Receipt"] = opts.Receipt
+	}
+	return headers, nil
+}
@@ -0,0 +1,105 @@
+package gophercloud
+
+import "sort"
+
+// CatalogEndpoint is an endpoint of a service, as listed in a ServiceCatalog.
+type CatalogEndpoint struct {
+	// ServiceID is the ID of the service, if known.
+	ServiceID string
+
+	// ServiceName is the name of the service, e.g. "nova".
+	ServiceName string
+
+	// ServiceType is the type of the service, e.g. "compute".
+	ServiceType string
+
+	// ID is the ID of the endpoint, if known.
+	ID string
+
+	// Region is the region of the endpoint.
+	Region string
+
+	// Availability is the interface of the endpoint.
+	Availability Availability
+
+	// URL is the normalized URL of the endpoint.
+	URL string
+}
+
+// ServiceCatalog is the list of the endpoints of a cloud, as returned by the
+// Identity service when authenticating. It doesn't depend on the version of
+// the Identity API, see openstack.V2ServiceCatalog and
+// openstack.V3ServiceCatalog.
+//
+// The catalog obtained when authenticating a ProviderClient is available
+// through its ServiceCatalog method.
+type ServiceCatalog struct {
+	Endpoints []CatalogEndpoint
+}
+
+// ServiceTypes returns the sorted types of the services in the catalog.
+func (c *ServiceCatalog) ServiceTypes() []string {
+	return c.distinct(func(e CatalogEndpoint) string { return e.ServiceType })
+}
+
+// Regions returns the sorted regions of the endpoints in the catalog.
+func (c *ServiceCatalog) Regions() []string {
+	return c.distinct(func(e CatalogEndpoint) string { return e.Region })
+}
+
+func (c *ServiceCatalog) distinct(field func(CatalogEndpoint) string) []string {
+	seen := make(map[string]bool)
+	values := []string{}
+	for _, e := range c.Endpoints {
+		if v := field(e); v != "" && !seen[v] {
+			seen[v] = true
+			values = append(values, v)
+		}
+	}
+	sort.Strings(values)
+	return values
+}
+
+// Find returns the endpoints of the catalog matching opts. Unlike EndpointURL,
+// empty fields of opts, including Availability, match any endpoint, and
+// AvailabilityFallbacks is ignored.
+func (c *ServiceCatalog) Find(opts EndpointOpts) []CatalogEndpoint {
+	var endpoints []CatalogEndpoint
+	for _, e := range c.Endpoints {
+		if (opts.Type == "" || e.ServiceType == opts.Type) &&
+			(opts.Name == "" || e.ServiceName == opts.Name) &&
+			(opts.Region == "" || e.Region == opts.Region) &&
+			(opts.Availability == "" || e.Availability == opts.Availability) {
+			endpoints = append(endpoints, e)
+		}
+	}
+	return endpoints
+}
+
+// EndpointURL returns the URL of the only endpoint matching opts, in the same
+// way as the EndpointLocator of a ProviderClient. Availability defaults to
+// AvailabilityPublic, and the availabilities of AvailabilityFallbacks are
+// tried in order when no endpoint has the requested one.
+func (c *ServiceCatalog) EndpointURL(opts EndpointOpts) (string, error) {
+	if opts.Availability == "" {
+		opts.Availability = AvailabilityPublic
+	}
+
+	for _, availability := range opts.Availabilities() {
+		o := opts
+		o.Availability = availability
+		endpoints := c.Find(o)
+		switch len(endpoints) {
+		case 0:
+			continue
+		case 1:
+			return endpoints[0].URL, nil
+		default:
+			err := ErrMultipleMatchingEndpoints{}
+			err.Endpoints = endpoints
+			return "", err
+		}
+	}
+
+	return "", &ErrEndpointNotFound{}
+}
@@ -43,6 +43,24 @@ pass in the parent provider, like so:
 
   client := openstack.NewComputeV2(provider, opts)
 
+The service catalog obtained when authenticating is kept on the provider,
+and can be inspected without authenticating again. EndpointOpts can list
+fallback availabilities, and EndpointOverrides replaces the catalog entries of
+some services, e.g. where the catalog isn't reachable from a private network:
+
+	catalog := provider.ServiceCatalog()
+	fmt.Println(catalog.ServiceTypes(), catalog.Regions())
+
+	opts := gophercloud.EndpointOpts{
+		Region:                "RegionOne",
+		Availability:          gophercloud.AvailabilityInternal,
+		AvailabilityFallbacks: []gophercloud.Availability{gophercloud.AvailabilityPublic},
+	}
+
+	provider.EndpointOverrides = map[string]string{
+		"compute": "https://nova.internal:8774/v2.1/",
+	}
+
 Resources
 
 Resource structs are the domain models that services make use of in order
@@ -86,6 +104,92 @@ intermediary processing on each page, you can use the AllPages method:
 	allPages, err := servers.List(client, nil).AllPages()
 	allServers, err := servers.ExtractServers(allPages)
 
+For large collections, an Iterator streams the resources one at a time,
+fetching pages only as they are needed:
+
+	it := pagination.NewIterator(servers.List(client, nil), servers.ExtractServers)
+	defer it.Close()
+	for it.Next() {
+		server := it.Value().(servers.Server)
+	}
+	err := it.Err()
+
+Requests can be cancelled, or bounded by a deadline, by setting a Context on
+the ProviderClient. It is used by every request issued through the client,
+including reauthentication and paging. A Pager's Context field can be set to
+use a different context while iterating:
+
+	provider.Context = ctx
+
+	pager := servers.List(client, nil)
+	pager.Context = listCtx
+
+A ProviderClient keeps the result of the authentication request, available
+through GetAuthResult, along with the expiry of its token. Setting a
+TokenRefreshMargin makes it reauthenticate shortly before the token expires,
+and a TokenCache lets short-lived programs reuse a token which is still valid:
+
+	provider, err := openstack.NewClient(opts.IdentityEndpoint)
+	provider.TokenRefreshMargin = time.Minute
+	provider.TokenCache = gophercloud.FileTokenCache{Dir: cacheDir}
+	err = openstack.Authenticate(provider, opts)
+
+Services such as Compute, Block Storage and Shared File Systems support
+microversions. Rather than setting the Microversion of a ServiceClient by
+hand, it can be negotiated with the service, up to a given microversion:
+
+	client, err := openstack.NewComputeV2(provider, opts)
+	microversion, err := utils.NegotiateMicroversion(client, "2.60")
+
+Operations which need a microversion outside of the range supported by the
+service, or above the Microversion requested by the client, then fail with an
+ErrMicroversionUnsupported error. A client which requests no microversion is
+assumed to rely on the default microversion of the service, and isn't checked.
+
+Requests which fail with an unexpected HTTP status return an
+ErrUnexpectedResponseCode, or one of the ErrDefault types embedding it. It
+holds the fault parsed from the response body and the ID the service assigned
+to the request. With Go 1.13 or later, errors can be matched by status code:
+
+	if errors.Is(err, gophercloud.ErrDefault404{}) {
+		// The server doesn't exist.
+	}
+
+	var e gophercloud.ErrUnexpectedResponseCode
+	if errors.As(err, &e) && e.Fault != nil {
+		log.Printf("%s: %s (request %s)", e.Fault.Type, e.Fault.Message, e.RequestID)
+	}
+
+A global request ID correlates the actions of several services on behalf of
+a single user action. It can be set for every request of a ProviderClient, or
+for a single call, and the ID each service assigned to a request is available
+from its Result:
+
+	provider.GlobalRequestID = gophercloud.NewGlobalRequestID()
+
+	result := servers.Create(client.WithGlobalRequestID(id), opts)
+	log.Printf("server creation handled as %s", result.RequestID())
+
+Programs issuing many requests concurrently through one ProviderClient can
+stay under the limits of the cloud with a RateLimiter, which limits the rate
+of requests per service type and method, and the number of requests in flight:
+
+	provider.RateLimiter = &gophercloud.RateLimiter{
+		Limits: map[gophercloud.RateLimitKey]gophercloud.RateLimit{
+			{ServiceType: "compute", Method: "POST"}: {Rate: 2, Burst: 5},
+		},
+		MaxConcurrent: 10,
+	}
+
+A MetricsObserver set on a ProviderClient receives the service type, method,
+URL template, status code, duration, and the number of retries and
+reauthentications of every request. The openmetrics package provides one
+which serves them in the OpenMetrics text format:
+
+	collector := &openmetrics.Collector{}
+	provider.Metrics = collector
+	http.Handle("/metrics", collector)
+
 This top-level package contains utility functions and data types that are used
 throughout the provider and service packages. Of particular note for end users
 are the AuthOptions and EndpointOpts structs.
@@ -51,6 +51,11 @@ type EndpointOpts struct {
 	// Availability is not required, and defaults to AvailabilityPublic. Not all
 	// providers or services offer all Availability options.
 	Availability Availability
+
+	// AvailabilityFallbacks [optional] are the availabilities tried in order
+	// when no endpoint of the requested Availability is found, e.g. to use
+	// the public endpoint of services which have no internal one.
+	AvailabilityFallbacks []Availability
 }
 
 /*
@@ -74,3 +79,12 @@ func (eo *EndpointOpts) ApplyDefaults(t string) {
 		eo.Availability = AvailabilityPublic
 	}
 }
+
+// Availabilities is an internal method to be used by provider
+// implementations.
+//
+// It returns the availabilities to look for, in order: Availability followed
+// by AvailabilityFallbacks.
+func (eo *EndpointOpts) Availabilities() []Availability {
+	return append([]Availability{eo.Availability}, eo.AvailabilityFallbacks...)
+}
@@ -2,6 +2,7 @@ package gophercloud
 
 import (
 	"fmt"
+	"net/http"
 	"strings"
 )
 
@@ -82,6 +83,14 @@ type ErrUnexpectedResponseCode struct {
 	Expected []int
 	Actual   int
 	Body     []byte
+
+	// Fault is the error described by Body, or nil if Body isn't in a known
+	// format.
+	Fault *Fault
+
+	// RequestID is the ID the service assigned to the request, taken from the
+	// X-Openstack-Request-Id or X-Compute-Request-Id response header.
+	RequestID string
 }
 
 func (e ErrUnexpectedResponseCode) Error() string {
@@ -92,6 +101,46 @@ func (e ErrUnexpectedResponseCode) Error() string {
 	return e.choseErrString()
 }
 
+// Is reports whether target designates the status code of the response, so
+// that errors.Is matches HTTP errors by status code:
+//
+//	errors.Is(err, gophercloud.ErrDefault404{})
+//	errors.Is(err, gophercloud.ErrUnexpectedResponseCode{Actual: http.StatusConflict})
+//
+// Is is promoted to the ErrDefault types, which embed ErrUnexpectedResponseCode.
+func (e ErrUnexpectedResponseCode) Is(target error) bool {
+	return e.Actual != 0 && statusCodeOf(target) == e.Actual
+}
+
+// statusCodeOf returns the status code designated by an HTTP error type, or 0.
+func statusCodeOf(err error) int {
+	switch err := err.(type) {
+	case ErrUnexpectedResponseCode:
+		return err.Actual
+	case *ErrUnexpectedResponseCode:
+		return err.Actual
+	case ErrDefault400, *ErrDefault400:
+		return http.StatusBadRequest
+	case ErrDefault401, *ErrDefault401:
+		return http.StatusUnauthorized
+	case ErrDefault403, *ErrDefault403:
+		return http.StatusForbidden
+	case ErrDefault404, *ErrDefault404:
+		return http.StatusNotFound
+	case ErrDefault405, *ErrDefault405:
+		return http.StatusMethodNotAllowed
+	case ErrDefault408, *ErrDefault408:
+		return http.StatusRequestTimeout
+	case ErrDefault429, *ErrDefault429:
+		return http.StatusTooManyRequests
+	case ErrDefault500, *ErrDefault500:
+		return http.StatusInternalServerError
+	case ErrDefault503, *ErrDefault503:
+		return http.StatusServiceUnavailable
+	}
+	return 0
+}
+
 // ErrDefault400 is the default error type returned on a 400 HTTP response code.
 type ErrDefault400 struct {
 	ErrUnexpectedResponseCode
@@ -175,6 +224,18 @@ func (e ErrDefault503) Error() string {
 		" overloading or maintenance. This is a temporary condition. Try again later."
 }
 
+// Unwrap returns the underlying ErrUnexpectedResponseCode, so that errors.As
+// can extract it from any of the ErrDefault types.
+func (e ErrDefault400) Unwrap() error { return e.ErrUnexpectedResponseCode }
+func (e ErrDefault401) Unwrap() error { return e.ErrUnexpectedResponseCode }
+func (e ErrDefault403) Unwrap() error { return e.ErrUnexpectedResponseCode }
+func (e ErrDefault404) Unwrap() error { return e.ErrUnexpectedResponseCode }
+func (e ErrDefault405) Unwrap() error { return e.ErrUnexpectedResponseCode }
+func (e ErrDefault408) Unwrap() error { return e.ErrUnexpectedResponseCode }
+func (e ErrDefault429) Unwrap() error { return e.ErrUnexpectedResponseCode }
+func (e ErrDefault500) Unwrap() error { return e.ErrUnexpectedResponseCode }
+func (e ErrDefault503) Unwrap() error { return e.ErrUnexpectedResponseCode }
+
 // Err400er is the interface resource error types implement to override the error message
 // from a 400 error.
 type Err400er interface {
@@ -250,6 +311,11 @@ func (e ErrUnableToReauthenticate) Error() string {
 	return e.choseErrString()
 }
 
+// Unwrap returns the error of the request which required reauthentication.
+func (e ErrUnableToReauthenticate) Unwrap() error {
+	return e.ErrOriginal
+}
+
 // ErrErrorAfterReauthentication is the error type returned when reauthentication
 // succeeds, but an error occurs afterword (usually an HTTP error).
 type ErrErrorAfterReauthentication struct {
@@ -262,6 +328,11 @@ func (e ErrErrorAfterReauthentication) Error() string {
 	return e.choseErrString()
 }
 
+// Unwrap returns the error of the request retried after reauthentication.
+func (e ErrErrorAfterReauthentication) Unwrap() error {
+	return e.ErrOriginal
+}
+
 // ErrServiceNotFound is returned when no service in a service catalog matches
 // the provided EndpointOpts. This is generally returned by provider service
 // factory methods like "NewComputeV2()" and can mean that a service is not
@@ -288,6 +359,17 @@ func (e ErrEndpointNotFound) Error() string {
 	return e.choseErrString()
 }
 
+// ErrMultipleMatchingEndpoints is returned by ServiceCatalog.EndpointURL when
+// more than one endpoint matches the provided EndpointOpts.
+type ErrMultipleMatchingEndpoints struct {
+	BaseError
+	Endpoints []CatalogEndpoint
+}
+
+func (e ErrMultipleMatchingEndpoints) Error() string {
+	return fmt.Sprintf("Discovered %d matching endpoints: %#v", len(e.Endpoints), e.Endpoints)
+}
+
 // ErrResourceNotFound is the error when trying to retrieve a resource's
 // ID by name and the resource doesn't exist.
 type ErrResourceNotFound struct {
@@ -424,6 +506,13 @@ func (e ErrMissingPassword) Error() string {
 	return "You must provide a password to authenticate"
 }
 
+// ErrAppCredMissingSecret indicates that no Application Credential Secret was provided with Application Credential ID or Name
+type ErrAppCredMissingSecret struct{ BaseError }
+
+func (e ErrAppCredMissingSecret) Error() string {
+	return "You must provide an Application Credential Secret"
+}
+
 // ErrScopeDomainIDOrDomainName indicates that a domain ID or Name was required in a Scope, but not present.
 type ErrScopeDomainIDOrDomainName struct{ BaseError }
 
@@ -445,9 +534,60 @@ func (e ErrScopeProjectIDAlone) Error() string {
 	return "ProjectID must be supplied alone in a Scope"
 }
 
+// ErrScopeSystemAlone indicates that System was requested along with a project or domain in a Scope.
+type ErrScopeSystemAlone struct{ BaseError }
+
+func (e ErrScopeSystemAlone) Error() string {
+	return "System must be supplied alone in a Scope"
+}
+
 // ErrScopeEmpty indicates that no credentials were provided in a Scope.
 type ErrScopeEmpty struct{ BaseError }
 
 func (e ErrScopeEmpty) Error() string {
 	return "You must provide either a Project or Domain in a Scope"
 }
+
+// ErrMicroversionUnsupported indicates that an operation requires a
+// microversion outside of the range supported by a service, or above the
+// microversion requested by the client.
+type ErrMicroversionUnsupported struct {
+	BaseError
+
+	// ServiceType is the type of the service, such as "compute".
+	ServiceType string
+
+	// Required is the microversion required by the operation.
+	Required string
+
+	// Min and Max are the microversions supported by the service. Both are
+	// empty if the service doesn't support microversions.
+	Min string
+	Max string
+
+	// NotRequested is true if the required microversion may be supported by
+	// the service, but is above Microversion, the microversion requested by
+	// the client.
+	NotRequested bool
+	Microversion string
+}
+
+func (e ErrMicroversionUnsupported) Error() string {
+	if e.NotRequested {
+		e.DefaultErrString = fmt.Sprintf(
+			"Microversion %s is required, but the %s client requests microversion %s",
+			e.Required, e.ServiceType, e.Microversion,
+		)
+	} else if e.Max == "" {
+		e.DefaultErrString = fmt.Sprintf(
+			"Microversion %s is required, but the %s service does not support microversions",
+			e.Required, e.ServiceType,
+		)
+	} else {
+		e.DefaultErrString = fmt.Sprintf(
+			"Microversion %s is required, but the %s service only supports microversions %s to %s",
+			e.Required, e.ServiceType, e.Min, e.Max,
+		)
+	}
+	return e.choseErrString()
+}
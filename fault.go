@@ -0,0 +1,115 @@
+package gophercloud
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// Fault is an error reported by an OpenStack service in the body of an HTTP
+// response.
+type Fault struct {
+	// Type is the kind of fault, such as "itemNotFound" or "NetworkNotFound".
+	Type string
+
+	// Message is the human readable description of the fault.
+	Message string
+
+	// Details holds additional information about the fault, if any.
+	Details string
+
+	// Code is the HTTP status code given in the body, if any.
+	Code int
+}
+
+// ParseFault parses the body of an error response. It understands the
+// formats of Nova, Cinder and Manila, Neutron, Keystone, Octavia and Heat. It
+// returns nil if the body isn't in any of these formats.
+func ParseFault(body []byte) *Fault {
+	var doc map[string]json.RawMessage
+	if err := json.Unmarshal(body, &doc); err != nil || len(doc) == 0 {
+		return nil
+	}
+
+	// Neutron: {"NeutronError": {"type": ..., "message": ..., "detail": ...}}
+	if raw, ok := doc["NeutronError"]; ok {
+		var f struct {
+			Type    string `json:"type"`
+			Message string `json:"message"`
+			Detail  string `json:"detail"`
+		}
+		if json.Unmarshal(raw, &f) == nil {
+			return &Fault{Type: f.Type, Message: f.Message, Details: f.Detail}
+		}
+	}
+
+	// Heat: {"code": ..., "title": ..., "explanation": ...,
+	//        "error": {"type": ..., "message": ..., "traceback": ...}}
+	// Keystone: {"error": {"code": ..., "title": ..., "message": ...}}
+	if raw, ok := doc["error"]; ok {
+		var f struct {
+			Code    int    `json:"code"`
+			Title   string `json:"title"`
+			Type    string `json:"type"`
+			Message string `json:"message"`
+		}
+		if json.Unmarshal(raw, &f) == nil && f.Message != "" {
+			if _, ok := doc["explanation"]; ok {
+				var heat struct {
+					Code        int    `json:"code"`
+					Explanation string `json:"explanation"`
+				}
+				json.Unmarshal(body, &heat)
+				return &Fault{Type: f.Type, Message: f.Message, Details: heat.Explanation, Code: heat.Code}
+			}
+			return &Fault{Type: f.Title, Message: f.Message, Code: f.Code}
+		}
+	}
+
+	// Octavia: {"faultcode": ..., "faultstring": ..., "debuginfo": ...}
+	if _, ok := doc["faultstring"]; ok {
+		var f struct {
+			FaultCode   string `json:"faultcode"`
+			FaultString string `json:"faultstring"`
+			DebugInfo   string `json:"debuginfo"`
+		}
+		json.Unmarshal(body, &f)
+		return &Fault{Type: f.FaultCode, Message: f.FaultString, Details: f.DebugInfo}
+	}
+
+	// Nova, Cinder and Manila: {"itemNotFound": {"code": ..., "message": ...,
+	// "details": ...}}, with the type of the fault as the only key.
+	if len(doc) == 1 {
+		for name, raw := range doc {
+			var f struct {
+				Code    int    `json:"code"`
+				Message string `json:"message"`
+				Details string `json:"details"`
+			}
+			if json.Unmarshal(raw, &f) == nil && f.Message != "" {
+				return &Fault{Type: name, Message: f.Message, Details: f.Details, Code: f.Code}
+			}
+		}
+	}
+
+	return nil
+}
+
+// requestIDHeaders are the response headers holding the ID a service assigned
+// to a request, in order of preference.
+var requestIDHeaders = []string{
+	"X-Openstack-Request-Id",
+	"X-Compute-Request-Id",
+	"X-Request-Id",
+}
+
+// RequestIDFromHeader returns the ID a service assigned to a request, as given
+// by the headers of its response. It returns an empty string if there is
+// none.
+func RequestIDFromHeader(header http.Header) string {
+	for _, h := range requestIDHeaders {
+		if id := header.Get(h); id != "" {
+			return id
+		}
+	}
+	return ""
+}
@@ -0,0 +1,74 @@
+package gophercloud
+
+import "net/http"
+
+// RequestInfo describes the request a RequestHook is called for.
+type RequestInfo struct {
+	// ServiceType is the type of the ServiceClient that issued the request,
+	// e.g. "compute". It is empty for requests issued by a ProviderClient
+	// directly, such as authentication requests.
+	ServiceType string
+
+	// Microversion is the microversion of the ServiceClient that issued the
+	// request, if any.
+	Microversion string
+
+	// Method is the HTTP method of the request.
+	Method string
+
+	// URL is the URL of the request.
+	URL string
+
+	// Attempt is the number of retries made so far for this request.
+	Attempt int
+
+	// Reauthenticated is true if the client has reauthenticated while
+	// handling this request.
+	Reauthenticated bool
+}
+
+// RequestHook allows observing the requests issued by a ProviderClient. Hooks
+// are called synchronously and must not modify the request or response
+// beyond what is documented for each method.
+type RequestHook interface {
+	// BeforeRequest is called before each attempt to send a request,
+	// including retries and attempts made after reauthenticating.
+	BeforeRequest(info RequestInfo, req *http.Request)
+
+	// AfterResponse is called for each response received, whatever its
+	// status code. A hook which consumes resp.Body must replace it with an
+	// equivalent reader.
+	AfterResponse(info RequestInfo, req *http.Request, resp *http.Response)
+
+	// OnReauth is called after the client has attempted to reauthenticate,
+	// either following a 401 response or, before the request is sent, to
+	// refresh a token about to expire. err is the result of the attempt.
+	OnReauth(info RequestInfo, err error)
+
+	// OnError is called once with the error returned by a failed request.
+	OnError(info RequestInfo, err error)
+}
+
+func (client *ProviderClient) beforeRequestHooks(info RequestInfo, req *http.Request) {
+	for _, h := range client.Hooks {
+		h.BeforeRequest(info, req)
+	}
+}
+
+func (client *ProviderClient) afterResponseHooks(info RequestInfo, req *http.Request, resp *http.Response) {
+	for _, h := range client.Hooks {
+		h.AfterResponse(info, req, resp)
+	}
+}
+
+func (client *ProviderClient) onReauthHooks(info RequestInfo, err error) {
+	for _, h := range client.Hooks {
+		h.OnReauth(info, err)
+	}
+}
+
+func (client *ProviderClient) onErrorHooks(info RequestInfo, err error) {
+	for _, h := range client.Hooks {
+		h.OnError(info, err)
+	}
+}
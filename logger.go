@@ -0,0 +1,189 @@
+package gophercloud
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Logger is the interface DebugLogger writes to. *log.Logger satisfies it.
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+// redactedHeaders lists the headers whose values DebugLogger never logs.
+var redactedHeaders = map[string]bool{
+	"X-Auth-Token":    true,
+	"X-Subject-Token": true,
+	"X-Service-Token": true,
+	"X-Auth-Key":      true,
+	"Authorization":   true,
+}
+
+// redactedFields lists the JSON fields whose values DebugLogger never logs,
+// wherever they appear in a request or response body.
+var redactedFields = map[string]bool{
+	"password": true,
+	"secret":   true,
+	"passcode": true,
+	"blob":     true,
+
+	"access_token":  true,
+	"id_token":      true,
+	"refresh_token": true,
+}
+
+const redacted = "***"
+
+// DebugLogger is a RequestHook which logs every request and response of a
+// ProviderClient, along with reauthentications and errors. Tokens and
+// credentials found in headers and JSON bodies are redacted.
+//
+//	provider.Hooks = append(provider.Hooks, &gophercloud.DebugLogger{})
+type DebugLogger struct {
+	// Logger receives the output. Defaults to the standard logger of the log
+	// package.
+	Logger Logger
+}
+
+func (l *DebugLogger) printf(format string, v ...interface{}) {
+	if l.Logger != nil {
+		l.Logger.Printf(format, v...)
+		return
+	}
+	log.Printf(format, v...)
+}
+
+// BeforeRequest implements RequestHook.
+func (l *DebugLogger) BeforeRequest(info RequestInfo, req *http.Request) {
+	var body []byte
+	if req.GetBody != nil {
+		if rc, err := req.GetBody(); err == nil {
+			body, _ = ioutil.ReadAll(rc)
+			rc.Close()
+		}
+	}
+
+	l.printf("[gophercloud] --> %s %s%s\n%s%s", req.Method, req.URL, formatRequestInfo(info),
+		formatHeaders(req.Header), formatBody(req.Header, body))
+}
+
+// AfterResponse implements RequestHook. JSON response bodies are read and
+// replaced with an in-memory copy so they can be logged.
+func (l *DebugLogger) AfterResponse(info RequestInfo, req *http.Request, resp *http.Response) {
+	var body []byte
+	if isJSON(resp.Header) && resp.Body != nil {
+		body, _ = ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+
+	l.printf("[gophercloud] <-- %s %s %s%s\n%s%s", resp.Status, req.Method, req.URL, formatRequestInfo(info),
+		formatHeaders(resp.Header), formatBody(resp.Header, body))
+}
+
+// OnReauth implements RequestHook.
+func (l *DebugLogger) OnReauth(info RequestInfo, err error) {
+	if err != nil {
+		l.printf("[gophercloud] reauthentication for %s %s failed: %s", info.Method, info.URL, err)
+		return
+	}
+	l.printf("[gophercloud] reauthenticated for %s %s", info.Method, info.URL)
+}
+
+// OnError implements RequestHook.
+func (l *DebugLogger) OnError(info RequestInfo, err error) {
+	l.printf("[gophercloud] %s %s failed%s: %s", info.Method, info.URL, formatRequestInfo(info), err)
+}
+
+func formatRequestInfo(info RequestInfo) string {
+	var parts []string
+	if info.ServiceType != "" {
+		parts = append(parts, "service="+info.ServiceType)
+	}
+	if info.Microversion != "" {
+		parts = append(parts, "microversion="+info.Microversion)
+	}
+	if info.Attempt > 0 {
+		parts = append(parts, "retry="+strconv.Itoa(info.Attempt))
+	}
+	if info.Reauthenticated {
+		parts = append(parts, "reauthenticated")
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return " (" + strings.Join(parts, " ") + ")"
+}
+
+func formatHeaders(h http.Header) string {
+	keys := make([]string, 0, len(h))
+	for k := range h {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	for _, k := range keys {
+		v := strings.Join(h[k], ", ")
+		if redactedHeaders[http.CanonicalHeaderKey(k)] {
+			v = redacted
+		}
+		b.WriteString(k + ": " + v + "\n")
+	}
+	return b.String()
+}
+
+func formatBody(h http.Header, body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	if !isJSON(h) {
+		return "<" + strconv.Itoa(len(body)) + " bytes omitted>\n"
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return "<invalid JSON body omitted>\n"
+	}
+	b, err := json.MarshalIndent(redactJSON(v, ""), "", "  ")
+	if err != nil {
+		return ""
+	}
+	return string(b) + "\n"
+}
+
+// redactJSON replaces the values of sensitive fields in a decoded JSON
+// document. Only scalar values are redacted, since Keystone also uses
+// "password" as the name of the password authentication method object.
+// Besides redactedFields, the "id" of a "token" object is redacted, since that
+// is how tokens are passed in Keystone authentication requests.
+func redactJSON(v interface{}, parent string) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			switch e.(type) {
+			case map[string]interface{}, []interface{}:
+				v[k] = redactJSON(e, k)
+			default:
+				if redactedFields[k] || (parent == "token" && k == "id") {
+					v[k] = redacted
+				}
+			}
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = redactJSON(e, parent)
+		}
+	}
+	return v
+}
+
+func isJSON(h http.Header) bool {
+	return strings.HasPrefix(h.Get("Content-Type"), "application/json")
+}
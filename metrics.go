@@ -0,0 +1,161 @@
+package gophercloud
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// RequestMetrics describes a call to ProviderClient.Request, once it has
+// completed.
+type RequestMetrics struct {
+	// ServiceType is the type of the ServiceClient that issued the request,
+	// e.g. "compute". It is empty for requests issued by a ProviderClient
+	// directly.
+	ServiceType string
+
+	// Method is the HTTP method of the request.
+	Method string
+
+	// URLTemplate is the path of the request URL with the IDs and names it
+	// contains collapsed, see URLTemplate.
+	URLTemplate string
+
+	// StatusCode is the status code of the last response received. It is 0
+	// if no response was received.
+	StatusCode int
+
+	// Duration is the time taken by the request, including retries and
+	// reauthentication.
+	Duration time.Duration
+
+	// Retries is the number of retries made.
+	Retries int
+
+	// Reauths is the number of times the client has reauthenticated while
+	// handling the request.
+	Reauths int
+
+	// Err is the error returned by the request, if any.
+	Err error
+}
+
+// MetricsObserver receives the metrics of the requests issued by a
+// ProviderClient. Set it on ProviderClient.Metrics. ObserveRequest is called
+// synchronously, once per call to Request, and may be called concurrently.
+//
+// The openmetrics package provides a MetricsObserver exposing the metrics in
+// the OpenMetrics text format.
+type MetricsObserver interface {
+	ObserveRequest(m RequestMetrics)
+}
+
+var (
+	uuidPattern      = regexp.MustCompile(`^[0-9a-fA-F]{8}-?[0-9a-fA-F]{4}-?[0-9a-fA-F]{4}-?[0-9a-fA-F]{4}-?[0-9a-fA-F]{12}$`)
+	hexIDPattern     = regexp.MustCompile(`^[0-9a-fA-F]{16,}$`)
+	numericIDPattern = regexp.MustCompile(`^[0-9]+$`)
+	versionPattern   = regexp.MustCompile(`^v[0-9]+(\.[0-9]+)?$`)
+)
+
+// namedCollections lists the collections whose members are addressed by
+// names chosen by users, or by keys, rather than by generated IDs.
+var namedCollections = map[string]bool{
+	"config":           true,
+	"events":           true,
+	"extra_specs":      true,
+	"flavors":          true,
+	"metadata":         true,
+	"namespaces":       true,
+	"objects":          true,
+	"os-extra_specs":   true,
+	"os-keypairs":      true,
+	"outputs":          true,
+	"properties":       true,
+	"queues":           true,
+	"regions":          true,
+	"resource_classes": true,
+	"resource_types":   true,
+	"resources":        true,
+	"stacks":           true,
+	"tags":             true,
+	"traits":           true,
+}
+
+// staticNames lists the fixed path segments which may follow a collection.
+var staticNames = map[string]bool{
+	"action":     true,
+	"count":      true,
+	"defaults":   true,
+	"detail":     true,
+	"statistics": true,
+	"summary":    true,
+}
+
+// URLTemplate returns the path of rawurl, requested from a service of the
+// given type, with the IDs and names it contains replaced by "{id}" and
+// "{name}", so that requests for different resources of the same kind can be
+// aggregated:
+//
+//	URLTemplate("compute", "https://nova.example.com/v2.1/servers/1e4d4bd4-0d1a-4ee5-a1a8-4a9c7f3f4b5e/action")
+//	// "/v2.1/servers/{id}/action"
+//
+// UUIDs, hexadecimal strings of at least 16 digits and integers are
+// recognized as IDs. The other segments which follow a collection of named
+// resources, such as "os-keypairs" or "stacks", are names unless they are
+// fixed, such as "detail".
+//
+// In Object Storage URLs, the segments following the version are replaced by
+// "{account}", "{container}" and "{object}", in this order.
+func URLTemplate(serviceType, rawurl string) string {
+	path := rawurl
+	if u, err := url.Parse(rawurl); err == nil {
+		path = u.EscapedPath()
+	}
+
+	segments := strings.Split(path, "/")
+	if serviceType == "object-store" {
+		if template, ok := objectStoreURLTemplate(segments); ok {
+			return template
+		}
+	}
+
+	var previous string
+	for i, s := range segments {
+		switch {
+		case uuidPattern.MatchString(s), hexIDPattern.MatchString(s), numericIDPattern.MatchString(s):
+			segments[i] = "{id}"
+		case s != "" && namedCollections[previous] && !staticNames[s]:
+			segments[i] = "{name}"
+		}
+		previous = s
+	}
+	return strings.Join(segments, "/")
+}
+
+// objectStoreURLTemplate replaces the segments of an Object Storage URL
+// following its version. It returns false if the URL has no version, as is
+// the case for the capabilities document.
+func objectStoreURLTemplate(segments []string) (string, bool) {
+	placeholders := []string{"{account}", "{container}", "{object}"}
+	for i, s := range segments {
+		if !versionPattern.MatchString(s) {
+			continue
+		}
+
+		for j, k := i+1, 0; j < len(segments); j, k = j+1, k+1 {
+			if k == len(placeholders)-1 {
+				// Object names may contain slashes.
+				if strings.Join(segments[j:], "") != "" {
+					segments = append(segments[:j], placeholders[k])
+				}
+				break
+			}
+			if segments[j] != "" {
+				segments[j] = placeholders[k]
+			}
+		}
+		return strings.Join(segments, "/"), true
+	}
+	return "", false
+}
@@ -0,0 +1,98 @@
+package gophercloud
+
+import (
+	"strconv"
+	"strings"
+)
+
+// CompareMicroversions compares two microversions such as "2.60". It returns
+// a negative number if a is lower than b, zero if they are equal and a
+// positive number if a is higher than b.
+func CompareMicroversions(a, b string) (int, error) {
+	aMajor, aMinor, err := parseMicroversion(a)
+	if err != nil {
+		return 0, err
+	}
+	bMajor, bMinor, err := parseMicroversion(b)
+	if err != nil {
+		return 0, err
+	}
+
+	if aMajor != bMajor {
+		return aMajor - bMajor, nil
+	}
+	return aMinor - bMinor, nil
+}
+
+func parseMicroversion(version string) (major, minor int, err error) {
+	parts := strings.Split(version, ".")
+	if len(parts) == 2 {
+		major, err = strconv.Atoi(parts[0])
+		if err == nil {
+			minor, err = strconv.Atoi(parts[1])
+		}
+		if err == nil && major >= 0 && minor >= 0 {
+			return major, minor, nil
+		}
+	}
+
+	e := ErrInvalidInput{}
+	e.Argument = "microversion"
+	e.Value = version
+	e.Info = "Microversions must be of the form <major>.<minor>"
+	return 0, 0, e
+}
+
+// RequireMicroversion returns an ErrMicroversionUnsupported error if version
+// is outside of the range of microversions supported by the service, as
+// recorded in MinMicroversion and MaxMicroversion, or above the Microversion
+// requested by the client. Operations which need a given microversion call it
+// before sending any request, so that they fail fast. The supported range is
+// only checked if it is known, and the requested microversion if it is set,
+// since a client requesting none relies on the default of the service.
+func (client *ServiceClient) RequireMicroversion(version string) error {
+	unsupported := ErrMicroversionUnsupported{
+		ServiceType: client.Type,
+		Required:    version,
+		Min:         client.MinMicroversion,
+		Max:         client.MaxMicroversion,
+	}
+
+	if client.MinMicroversion != "" {
+		c, err := CompareMicroversions(version, client.MinMicroversion)
+		if err != nil {
+			return err
+		}
+		if c < 0 {
+			return unsupported
+		}
+	}
+
+	if client.MaxMicroversion != "" {
+		c, err := CompareMicroversions(version, client.MaxMicroversion)
+		if err != nil {
+			return err
+		}
+		if c > 0 {
+			return unsupported
+		}
+	}
+
+	// "latest" stands for the highest supported microversion, which was
+	// checked above if known.
+	if client.Microversion == "" || client.Microversion == "latest" {
+		return nil
+	}
+
+	unsupported.Microversion = client.Microversion
+	unsupported.NotRequested = true
+	c, err := CompareMicroversions(client.Microversion, version)
+	if err != nil {
+		return err
+	}
+	if c < 0 {
+		return unsupported
+	}
+
+	return nil
+}
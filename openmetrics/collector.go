@@ -0,0 +1,233 @@
+package openmetrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// DefaultNamespace prefixes the names of the metrics of a Collector which
+// doesn't set Namespace.
+const DefaultNamespace = "gophercloud"
+
+// ContentType is the media type of the OpenMetrics text format.
+const ContentType = "application/openmetrics-text; version=1.0.0; charset=utf-8"
+
+// DefaultBuckets are the upper bounds, in seconds, of the buckets of the
+// request duration histogram of a Collector which doesn't set Buckets.
+var DefaultBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30}
+
+// Collector is a gophercloud.MetricsObserver which aggregates the metrics of
+// requests, and writes them in the OpenMetrics text format. It is also an
+// http.Handler serving them.
+//
+// The zero value is ready to use. Namespace and Buckets must not be modified
+// once the Collector is in use.
+type Collector struct {
+	// Namespace prefixes the names of the metrics. Defaults to
+	// DefaultNamespace.
+	Namespace string
+
+	// Buckets are the upper bounds, in seconds, of the buckets of the request
+	// duration histogram, in increasing order. Defaults to DefaultBuckets.
+	Buckets []float64
+
+	mu       sync.Mutex
+	requests map[requestKey]uint64
+	series   map[seriesKey]*series
+}
+
+// seriesKey identifies the requests aggregated together.
+type seriesKey struct {
+	service string
+	method  string
+	url     string
+}
+
+type requestKey struct {
+	seriesKey
+	status string
+}
+
+type series struct {
+	// buckets holds the cumulative counts of the histogram.
+	buckets []uint64
+	sum     float64
+	count   uint64
+	retries uint64
+	reauths uint64
+}
+
+func (c *Collector) namespace() string {
+	if c.Namespace != "" {
+		return c.Namespace
+	}
+	return DefaultNamespace
+}
+
+func (c *Collector) buckets() []float64 {
+	if c.Buckets != nil {
+		return c.Buckets
+	}
+	return DefaultBuckets
+}
+
+// ObserveRequest implements gophercloud.MetricsObserver.
+func (c *Collector) ObserveRequest(m gophercloud.RequestMetrics) {
+	key := seriesKey{service: m.ServiceType, method: m.Method, url: m.URLTemplate}
+	status := "none"
+	if m.StatusCode != 0 {
+		status = strconv.Itoa(m.StatusCode)
+	}
+	seconds := m.Duration.Seconds()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.requests == nil {
+		c.requests = make(map[requestKey]uint64)
+		c.series = make(map[seriesKey]*series)
+	}
+	c.requests[requestKey{seriesKey: key, status: status}]++
+
+	s, ok := c.series[key]
+	if !ok {
+		s = &series{buckets: make([]uint64, len(c.buckets()))}
+		c.series[key] = s
+	}
+	for i, bound := range c.buckets() {
+		if seconds <= bound {
+			s.buckets[i]++
+		}
+	}
+	s.sum += seconds
+	s.count++
+	s.retries += uint64(m.Retries)
+	s.reauths += uint64(m.Reauths)
+}
+
+// WriteTo writes the metrics collected so far to w, in the OpenMetrics text
+// format. It implements io.WriterTo.
+func (c *Collector) WriteTo(w io.Writer) (int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ns := c.namespace()
+	cw := &countingWriter{w: bufio.NewWriter(w)}
+
+	requestKeys := make([]requestKey, 0, len(c.requests))
+	for k := range c.requests {
+		requestKeys = append(requestKeys, k)
+	}
+	sort.Slice(requestKeys, func(i, j int) bool {
+		if requestKeys[i].seriesKey != requestKeys[j].seriesKey {
+			return requestKeys[i].seriesKey.less(requestKeys[j].seriesKey)
+		}
+		return requestKeys[i].status < requestKeys[j].status
+	})
+
+	seriesKeys := make([]seriesKey, 0, len(c.series))
+	for k := range c.series {
+		seriesKeys = append(seriesKeys, k)
+	}
+	sort.Slice(seriesKeys, func(i, j int) bool {
+		return seriesKeys[i].less(seriesKeys[j])
+	})
+
+	cw.printf("# TYPE %s_requests counter\n", ns)
+	cw.printf("# HELP %s_requests Requests issued, by status code.\n", ns)
+	for _, k := range requestKeys {
+		cw.printf("%s_requests_total{%s,status=\"%s\"} %d\n", ns, k.labels(), escape(k.status), c.requests[k])
+	}
+
+	cw.printf("# TYPE %s_request_duration_seconds histogram\n", ns)
+	cw.printf("# HELP %s_request_duration_seconds Duration of requests, including retries and reauthentication.\n", ns)
+	for _, k := range seriesKeys {
+		s := c.series[k]
+		for i, bound := range c.buckets() {
+			cw.printf("%s_request_duration_seconds_bucket{%s,le=\"%s\"} %d\n", ns, k.labels(), formatFloat(bound), s.buckets[i])
+		}
+		cw.printf("%s_request_duration_seconds_bucket{%s,le=\"+Inf\"} %d\n", ns, k.labels(), s.count)
+		cw.printf("%s_request_duration_seconds_sum{%s} %s\n", ns, k.labels(), formatFloat(s.sum))
+		cw.printf("%s_request_duration_seconds_count{%s} %d\n", ns, k.labels(), s.count)
+	}
+
+	cw.printf("# TYPE %s_request_retries counter\n", ns)
+	cw.printf("# HELP %s_request_retries Retries made after transient failures.\n", ns)
+	for _, k := range seriesKeys {
+		cw.printf("%s_request_retries_total{%s} %d\n", ns, k.labels(), c.series[k].retries)
+	}
+
+	cw.printf("# TYPE %s_request_reauths counter\n", ns)
+	cw.printf("# HELP %s_request_reauths Reauthentications made while handling requests.\n", ns)
+	for _, k := range seriesKeys {
+		cw.printf("%s_request_reauths_total{%s} %d\n", ns, k.labels(), c.series[k].reauths)
+	}
+
+	cw.printf("# EOF\n")
+	if cw.err == nil {
+		cw.err = cw.w.Flush()
+	}
+	return cw.n, cw.err
+}
+
+// ServeHTTP implements http.Handler, serving the metrics collected so far.
+func (c *Collector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", ContentType)
+	c.WriteTo(w)
+}
+
+func (k seriesKey) less(other seriesKey) bool {
+	if k.service != other.service {
+		return k.service < other.service
+	}
+	if k.url != other.url {
+		return k.url < other.url
+	}
+	return k.method < other.method
+}
+
+func (k seriesKey) labels() string {
+	return fmt.Sprintf("service=\"%s\",method=\"%s\",url=\"%s\"", escape(k.service), escape(k.method), escape(k.url))
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// escape escapes a label value.
+func escape(v string) string {
+	return labelEscaper.Replace(v)
+}
+
+// formatFloat formats a number as OpenMetrics expects it, e.g. "1.0" rather
+// than "1".
+func formatFloat(f float64) string {
+	s := strconv.FormatFloat(f, 'g', -1, 64)
+	if f == math.Trunc(f) && !strings.ContainsAny(s, "e.") {
+		s += ".0"
+	}
+	return s
+}
+
+// countingWriter counts the bytes written and keeps the first error.
+type countingWriter struct {
+	w   *bufio.Writer
+	n   int64
+	err error
+}
+
+func (cw *countingWriter) printf(format string, v ...interface{}) {
+	if cw.err != nil {
+		return
+	}
+	n, err := fmt.Fprintf(cw.w, format, v...)
+	cw.n += int64(n)
+	cw.err = err
+}
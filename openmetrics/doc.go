@@ -0,0 +1,32 @@
+/*
+Package openmetrics collects the metrics of the requests issued by a
+gophercloud ProviderClient, and exposes them in the OpenMetrics text format so
+that they can be scraped by Prometheus or any compatible system.
+
+For each service type, HTTP method and URL template (see
+gophercloud.URLTemplate), a Collector counts the requests by status code, and
+keeps a histogram of their duration along with the number of retries and
+reauthentications they needed.
+
+Example to Collect Metrics
+
+	collector := &openmetrics.Collector{}
+	provider.Metrics = collector
+
+	http.Handle("/metrics", collector)
+
+Example to Write Metrics
+
+	collector.WriteTo(os.Stdout)
+
+Example Output
+
+	# TYPE gophercloud_requests counter
+	# HELP gophercloud_requests Requests issued, by status code.
+	gophercloud_requests_total{service="compute",method="GET",url="/v2.1/servers/{id}",status="200"} 3
+	# TYPE gophercloud_request_duration_seconds histogram
+	# HELP gophercloud_request_duration_seconds Duration of requests, including retries and reauthentication.
+	gophercloud_request_duration_seconds_bucket{service="compute",method="GET",url="/v2.1/servers/{id}",le="0.005"} 0
+	...
+*/
+package openmetrics
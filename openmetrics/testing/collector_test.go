@@ -0,0 +1,90 @@
+package testing
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openmetrics"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+const expectedOutput = `# TYPE test_requests counter
+# HELP test_requests Requests issued, by status code.
+test_requests_total{service="compute",method="GET",url="/v2.1/servers/{id}",status="200"} 2
+test_requests_total{service="compute",method="GET",url="/v2.1/servers/{id}",status="404"} 1
+test_requests_total{service="network",method="POST",url="/v2.0/ports",status="none"} 1
+# TYPE test_request_duration_seconds histogram
+# HELP test_request_duration_seconds Duration of requests, including retries and reauthentication.
+test_request_duration_seconds_bucket{service="compute",method="GET",url="/v2.1/servers/{id}",le="0.1"} 2
+test_request_duration_seconds_bucket{service="compute",method="GET",url="/v2.1/servers/{id}",le="1.0"} 3
+test_request_duration_seconds_bucket{service="compute",method="GET",url="/v2.1/servers/{id}",le="+Inf"} 3
+test_request_duration_seconds_sum{service="compute",method="GET",url="/v2.1/servers/{id}"} 0.55
+test_request_duration_seconds_count{service="compute",method="GET",url="/v2.1/servers/{id}"} 3
+test_request_duration_seconds_bucket{service="network",method="POST",url="/v2.0/ports",le="0.1"} 0
+test_request_duration_seconds_bucket{service="network",method="POST",url="/v2.0/ports",le="1.0"} 0
+test_request_duration_seconds_bucket{service="network",method="POST",url="/v2.0/ports",le="+Inf"} 1
+test_request_duration_seconds_sum{service="network",method="POST",url="/v2.0/ports"} 2.0
+test_request_duration_seconds_count{service="network",method="POST",url="/v2.0/ports"} 1
+# TYPE test_request_retries counter
+# HELP test_request_retries Retries made after transient failures.
+test_request_retries_total{service="compute",method="GET",url="/v2.1/servers/{id}"} 2
+test_request_retries_total{service="network",method="POST",url="/v2.0/ports"} 0
+# TYPE test_request_reauths counter
+# HELP test_request_reauths Reauthentications made while handling requests.
+test_request_reauths_total{service="compute",method="GET",url="/v2.1/servers/{id}"} 0
+test_request_reauths_total{service="network",method="POST",url="/v2.0/ports"} 1
+# EOF
+`
+
+func newCollector() *openmetrics.Collector {
+	c := &openmetrics.Collector{
+		Namespace: "test",
+		Buckets:   []float64{0.1, 1},
+	}
+	for _, m := range []gophercloud.RequestMetrics{
+		{ServiceType: "compute", Method: "GET", URLTemplate: "/v2.1/servers/{id}", StatusCode: 200, Duration: 25 * time.Millisecond},
+		{ServiceType: "compute", Method: "GET", URLTemplate: "/v2.1/servers/{id}", StatusCode: 200, Duration: 500 * time.Millisecond, Retries: 2},
+		{ServiceType: "compute", Method: "GET", URLTemplate: "/v2.1/servers/{id}", StatusCode: 404, Duration: 25 * time.Millisecond},
+		{ServiceType: "network", Method: "POST", URLTemplate: "/v2.0/ports", Duration: 2 * time.Second, Reauths: 1},
+	} {
+		c.ObserveRequest(m)
+	}
+	return c
+}
+
+func TestCollectorWriteTo(t *testing.T) {
+	var buf bytes.Buffer
+	n, err := newCollector().WriteTo(&buf)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, int64(buf.Len()), n)
+	th.AssertEquals(t, expectedOutput, buf.String())
+}
+
+func TestCollectorServeHTTP(t *testing.T) {
+	rec := httptest.NewRecorder()
+	newCollector().ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+
+	th.AssertEquals(t, http.StatusOK, rec.Code)
+	th.AssertEquals(t, openmetrics.ContentType, rec.Header().Get("Content-Type"))
+	th.AssertEquals(t, expectedOutput, rec.Body.String())
+}
+
+func TestCollectorEmpty(t *testing.T) {
+	var buf bytes.Buffer
+	_, err := new(openmetrics.Collector).WriteTo(&buf)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, `# TYPE gophercloud_requests counter
+# HELP gophercloud_requests Requests issued, by status code.
+# TYPE gophercloud_request_duration_seconds histogram
+# HELP gophercloud_request_duration_seconds Duration of requests, including retries and reauthentication.
+# TYPE gophercloud_request_retries counter
+# HELP gophercloud_request_retries Retries made after transient failures.
+# TYPE gophercloud_request_reauths counter
+# HELP gophercloud_request_reauths Reauthentications made while handling requests.
+# EOF
+`, buf.String())
+}
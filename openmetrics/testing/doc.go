@@ -0,0 +1,2 @@
+// openmetrics unit tests
+package testing
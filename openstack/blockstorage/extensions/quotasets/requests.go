@@ -8,20 +8,23 @@ import (
 
 // Get returns public data about a previously created QuotaSet.
 func Get(client *gophercloud.ServiceClient, projectID string) (r GetResult) {
-	_, r.Err = client.Get(getURL(client, projectID), &r.Body, nil)
+	resp, err := client.Get(getURL(client, projectID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
 
 // GetDefaults returns public data about the project's default block storage quotas.
 func GetDefaults(client *gophercloud.ServiceClient, projectID string) (r GetResult) {
-	_, r.Err = client.Get(getDefaultsURL(client, projectID), &r.Body, nil)
+	resp, err := client.Get(getDefaultsURL(client, projectID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
 
 // GetUsage returns detailed public data about a previously created QuotaSet.
 func GetUsage(client *gophercloud.ServiceClient, projectID string) (r GetUsageResult) {
 	u := fmt.Sprintf("%s?usage=true", getURL(client, projectID))
-	_, r.Err = client.Get(u, &r.Body, nil)
+	resp, err := client.Get(u, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
 
@@ -33,7 +36,8 @@ func Update(client *gophercloud.ServiceClient, projectID string, opts UpdateOpts
 		return
 	}
 
-	_, r.Err = client.Put(updateURL(client, projectID), reqBody, &r.Body, &gophercloud.RequestOpts{OkCodes: []int{200}})
+	resp, err := client.Put(updateURL(client, projectID), reqBody, &r.Body, &gophercloud.RequestOpts{OkCodes: []int{200}})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return r
 }
 
@@ -105,7 +105,8 @@ func Get(c *gophercloud.ServiceClient, idOrURL string) (r GetResult) {
 	} else {
 		url = getURL(c, idOrURL)
 	}
-	_, r.Err = c.Get(url, &r.Body, nil)
+	resp, err := c.Get(url, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
 
@@ -280,6 +281,7 @@ func Delete(c *gophercloud.ServiceClient, idOrURL string) (r DeleteResult) {
 	} else {
 		url = deleteURL(c, idOrURL)
 	}
-	_, r.Err = c.Delete(url, nil)
+	resp, err := c.Delete(url, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
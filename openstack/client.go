@@ -1,6 +1,7 @@
 package openstack
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 
@@ -135,7 +136,7 @@ func v2auth(client *gophercloud.ProviderClient, endpoint string, options gopherc
 
 	result := tokens2.Create(v2Client, v2Opts)
 
-	token, err := result.ExtractToken()
+	err = client.SetTokenAndAuthResult(result)
 	if err != nil {
 		return err
 	}
@@ -144,26 +145,32 @@ func v2auth(client *gophercloud.ProviderClient, endpoint string, options gopherc
 	if err != nil {
 		return err
 	}
+	client.SetServiceCatalog(V2ServiceCatalog(catalog))
 
 	if options.AllowReauth {
 		// here we're creating a throw-away client (tac). it's a copy of the user's provider client, but
 		// with the token and reauth func zeroed out. combined with setting `AllowReauth` to `false`,
 		// this should retry authentication only once
 		tac := *client
+		tac.SetThrowaway(true)
 		tac.ReauthFunc = nil
+		tac.ReauthContextFunc = nil
 		tac.TokenID = ""
 		tao := options
 		tao.AllowReauth = false
-		client.ReauthFunc = func() error {
+		client.ReauthContextFunc = func(ctx context.Context) error {
+			tac.Context = ctx
 			err := v2auth(&tac, endpoint, tao, eo)
 			if err != nil {
 				return err
 			}
-			client.TokenID = tac.TokenID
+			client.CopyTokenFrom(&tac)
 			return nil
 		}
+		client.ReauthFunc = func() error {
+			return client.ReauthContextFunc(client.Context)
+		}
 	}
-	client.TokenID = token.ID
 	client.EndpointLocator = func(opts gophercloud.EndpointOpts) (string, error) {
 		return V2EndpointURL(catalog, opts)
 	}
@@ -187,9 +194,30 @@ func v3auth(client *gophercloud.ProviderClient, endpoint string, opts tokens3.Au
 		v3Client.Endpoint = endpoint
 	}
 
-	result := tokens3.Create(v3Client, opts)
+	var cacheKey string
+	if client.TokenCache != nil {
+		cacheKey, err = tokenCacheKey(v3Client.Endpoint, opts)
+		if err != nil {
+			return err
+		}
+	}
 
-	token, err := result.ExtractToken()
+	// A throwaway client is reauthenticating after the cached token was
+	// rejected or is about to expire, so the cache isn't looked up then.
+	var result tokens3.CreateResult
+	cached := false
+	if client.TokenCache != nil && !client.IsThrowaway() {
+		result, cached = loadCachedToken(client, cacheKey)
+	}
+	if !cached {
+		if m, ok := opts.(tokens3.AuthMethod); ok {
+			result = m.CreateToken(v3Client)
+		} else {
+			result = tokens3.Create(v3Client, opts)
+		}
+	}
+
+	err = client.SetTokenAndAuthResult(result)
 	if err != nil {
 		return err
 	}
@@ -198,18 +226,25 @@ func v3auth(client *gophercloud.ProviderClient, endpoint string, opts tokens3.Au
 	if err != nil {
 		return err
 	}
+	client.SetServiceCatalog(V3ServiceCatalog(catalog))
 
-	client.TokenID = token.ID
+	if client.TokenCache != nil && !cached {
+		storeCachedToken(client.TokenCache, cacheKey, result)
+	}
 
 	if opts.CanReauth() {
 		// here we're creating a throw-away client (tac). it's a copy of the user's provider client, but
 		// with the token and reauth func zeroed out. combined with setting `AllowReauth` to `false`,
 		// this should retry authentication only once
 		tac := *client
+		tac.SetThrowaway(true)
 		tac.ReauthFunc = nil
+		tac.ReauthContextFunc = nil
 		tac.TokenID = ""
 		var tao tokens3.AuthOptionsBuilder
 		switch ot := opts.(type) {
+		case tokens3.AuthMethod:
+			tao = ot.WithoutReauth()
 		case *gophercloud.AuthOptions:
 			o := *ot
 			o.AllowReauth = false
@@ -221,14 +256,18 @@ func v3auth(client *gophercloud.ProviderClient, endpoint string, opts tokens3.Au
 		default:
 			tao = opts
 		}
-		client.ReauthFunc = func() error {
+		client.ReauthContextFunc = func(ctx context.Context) error {
+			tac.Context = ctx
 			err := v3auth(&tac, endpoint, tao, eo)
 			if err != nil {
 				return err
 			}
-			client.TokenID = tac.TokenID
+			client.CopyTokenFrom(&tac)
 			return nil
 		}
+		client.ReauthFunc = func() error {
+			return client.ReauthContextFunc(client.Context)
+		}
 	}
 	client.EndpointLocator = func(opts gophercloud.EndpointOpts) (string, error) {
 		return V3EndpointURL(catalog, opts)
@@ -245,7 +284,7 @@ func NewIdentityV2(client *gophercloud.ProviderClient, eo gophercloud.EndpointOp
 	var err error
 	if !reflect.DeepEqual(eo, gophercloud.EndpointOpts{}) {
 		eo.ApplyDefaults(clientType)
-		endpoint, err = client.EndpointLocator(eo)
+		endpoint, err = locateEndpoint(client, eo)
 		if err != nil {
 			return nil, err
 		}
@@ -266,7 +305,7 @@ func NewIdentityV3(client *gophercloud.ProviderClient, eo gophercloud.EndpointOp
 	var err error
 	if !reflect.DeepEqual(eo, gophercloud.EndpointOpts{}) {
 		eo.ApplyDefaults(clientType)
-		endpoint, err = client.EndpointLocator(eo)
+		endpoint, err = locateEndpoint(client, eo)
 		if err != nil {
 			return nil, err
 		}
@@ -292,10 +331,19 @@ func NewIdentityV3(client *gophercloud.ProviderClient, eo gophercloud.EndpointOp
 	}, nil
 }
 
+// locateEndpoint returns the endpoint URL overridden for the service type of
+// eo, if any, or the one found by the EndpointLocator of the client.
+func locateEndpoint(client *gophercloud.ProviderClient, eo gophercloud.EndpointOpts) (string, error) {
+	if url, ok := client.EndpointOverrides[eo.Type]; ok {
+		return gophercloud.NormalizeURL(url), nil
+	}
+	return client.EndpointLocator(eo)
+}
+
 func initClientOpts(client *gophercloud.ProviderClient, eo gophercloud.EndpointOpts, clientType string) (*gophercloud.ServiceClient, error) {
 	sc := new(gophercloud.ServiceClient)
 	eo.ApplyDefaults(clientType)
-	url, err := client.EndpointLocator(eo)
+	url, err := locateEndpoint(client, eo)
 	if err != nil {
 		return sc, err
 	}
@@ -0,0 +1,55 @@
+/*
+Package clientconfig loads client configuration from the clouds.yaml files
+used by the other OpenStack clients and tools.
+
+Clouds are read from clouds.yaml, with secrets optionally kept apart in
+secure.yaml. A cloud may reference a vendor profile from clouds-public.yaml
+through its "profile" key. Each file is looked up in the current directory,
+then in ~/.config/openstack (or $XDG_CONFIG_HOME/openstack), then in
+/etc/openstack. The OS_CLIENT_CONFIG_FILE and OS_CLIENT_SECURE_FILE environment
+variables override the location of clouds.yaml and secure.yaml.
+
+The cloud is selected by ClientOpts.Cloud, or by the OS_CLOUD environment
+variable if that is empty.
+
+Example to Authenticate Using clouds.yaml
+
+	opts := &clientconfig.ClientOpts{
+		Cloud: "mycloud",
+	}
+
+	provider, err := clientconfig.AuthenticatedClient(opts)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Create a Service Client Using clouds.yaml
+
+	computeClient, err := clientconfig.NewServiceClient("compute", opts)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Retrieve the Settings of a Cloud
+
+	authOpts, err := clientconfig.AuthOptions(opts)
+	if err != nil {
+		panic(err)
+	}
+
+	endpointOpts, err := clientconfig.EndpointOpts(opts)
+	if err != nil {
+		panic(err)
+	}
+
+	provider, err := openstack.AuthenticatedClient(*authOpts)
+	if err != nil {
+		panic(err)
+	}
+
+	networkClient, err := openstack.NewNetworkV2(provider, endpointOpts)
+	if err != nil {
+		panic(err)
+	}
+*/
+package clientconfig
@@ -0,0 +1,366 @@
+package clientconfig
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack"
+)
+
+// ClientOpts selects the cloud to load and overrides some of its settings.
+type ClientOpts struct {
+	// Cloud is the name of the cloud in clouds.yaml. Defaults to the value of
+	// the OS_CLOUD environment variable.
+	Cloud string
+
+	// RegionName, if set, overrides the region_name of the cloud.
+	RegionName string
+}
+
+// ErrCloudNotFound is returned when the requested cloud isn't defined in
+// clouds.yaml.
+type ErrCloudNotFound struct {
+	gophercloud.BaseError
+	Cloud string
+}
+
+func (e ErrCloudNotFound) Error() string {
+	return fmt.Sprintf("Cloud [%s] was not found in clouds.yaml", e.Cloud)
+}
+
+// GetCloudFromYAML returns the cloud selected by opts, with the settings of
+// its profile from clouds-public.yaml and of its entry in secure.yaml merged
+// in. Settings from clouds.yaml take precedence over the profile, and
+// settings from secure.yaml take precedence over both.
+func GetCloudFromYAML(opts *ClientOpts) (*Cloud, error) {
+	if opts == nil {
+		opts = new(ClientOpts)
+	}
+
+	name := opts.Cloud
+	if name == "" {
+		name = os.Getenv("OS_CLOUD")
+	}
+	if name == "" {
+		return nil, gophercloud.ErrMissingEnvironmentVariable{EnvironmentVariable: "OS_CLOUD"}
+	}
+
+	clouds, err := loadClouds("clouds", "OS_CLIENT_CONFIG_FILE", "clouds.yaml", "clouds.yml")
+	if err != nil {
+		return nil, err
+	}
+	raw, ok := clouds[name].(map[interface{}]interface{})
+	if !ok {
+		return nil, ErrCloudNotFound{Cloud: name}
+	}
+
+	secure, err := loadClouds("clouds", "OS_CLIENT_SECURE_FILE", "secure.yaml", "secure.yml")
+	if err != nil {
+		return nil, err
+	}
+	if s, ok := secure[name].(map[interface{}]interface{}); ok {
+		raw = mergeCloud(raw, s)
+	}
+
+	if profile, ok := raw["profile"].(string); ok && profile != "" {
+		public, err := loadClouds("public-clouds", "", "clouds-public.yaml", "clouds-public.yml")
+		if err != nil {
+			return nil, err
+		}
+		p, ok := public[profile].(map[interface{}]interface{})
+		if !ok {
+			return nil, fmt.Errorf("Profile [%s] of cloud [%s] was not found in clouds-public.yaml", profile, name)
+		}
+		raw = mergeCloud(p, raw)
+	}
+
+	cloud := new(Cloud)
+	if err := decodeCloud(raw, cloud); err != nil {
+		return nil, err
+	}
+
+	if opts.RegionName != "" {
+		cloud.RegionName = opts.RegionName
+	}
+
+	return cloud, nil
+}
+
+// AuthOptions returns the gophercloud.AuthOptions of the cloud selected by
+// opts. Reauthentication is allowed.
+func AuthOptions(opts *ClientOpts) (*gophercloud.AuthOptions, error) {
+	cloud, err := GetCloudFromYAML(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return cloud.AuthOptions()
+}
+
+// AuthOptions returns the gophercloud.AuthOptions for the cloud.
+func (cloud *Cloud) AuthOptions() (*gophercloud.AuthOptions, error) {
+	auth := cloud.AuthInfo
+	if auth == nil || auth.AuthURL == "" {
+		return nil, gophercloud.ErrMissingInput{Argument: "auth_url"}
+	}
+
+	ao := &gophercloud.AuthOptions{
+		IdentityEndpoint: auth.AuthURL,
+		TokenID:          auth.Token,
+		Username:         auth.Username,
+		UserID:           auth.UserID,
+		Password:         auth.Password,
+		AllowReauth:      true,
+
+		ApplicationCredentialID:     auth.ApplicationCredentialID,
+		ApplicationCredentialName:   auth.ApplicationCredentialName,
+		ApplicationCredentialSecret: auth.ApplicationCredentialSecret,
+	}
+
+	if strings.HasPrefix(cloud.IdentityAPIVersion, "2") {
+		ao.TenantID = auth.ProjectID
+		ao.TenantName = auth.ProjectName
+		return ao, nil
+	}
+
+	// A username is only unique within a domain.
+	if auth.Username != "" && auth.UserID == "" {
+		switch {
+		case auth.UserDomainID != "":
+			ao.DomainID = auth.UserDomainID
+		case auth.UserDomainName != "":
+			ao.DomainName = auth.UserDomainName
+		default:
+			ao.DomainID = auth.DefaultDomain
+		}
+	}
+
+	scope := new(gophercloud.AuthScope)
+	switch {
+	case auth.SystemScope == "all":
+		scope.System = true
+	case auth.ProjectID != "":
+		scope.ProjectID = auth.ProjectID
+	case auth.ProjectName != "":
+		scope.ProjectName = auth.ProjectName
+		switch {
+		case auth.ProjectDomainID != "":
+			scope.DomainID = auth.ProjectDomainID
+		case auth.ProjectDomainName != "":
+			scope.DomainName = auth.ProjectDomainName
+		case auth.DefaultDomain != "":
+			scope.DomainID = auth.DefaultDomain
+		case auth.UserDomainID != "":
+			scope.DomainID = auth.UserDomainID
+		default:
+			scope.DomainName = auth.UserDomainName
+		}
+	case auth.DomainID != "":
+		scope.DomainID = auth.DomainID
+	case auth.DomainName != "":
+		scope.DomainName = auth.DomainName
+	default:
+		// Let Keystone choose the user's default project, if any.
+		scope = nil
+	}
+	ao.Scope = scope
+
+	return ao, nil
+}
+
+// EndpointOpts returns the gophercloud.EndpointOpts of the cloud selected by
+// opts. The Type is left empty for the service client constructors to fill.
+func EndpointOpts(opts *ClientOpts) (gophercloud.EndpointOpts, error) {
+	cloud, err := GetCloudFromYAML(opts)
+	if err != nil {
+		return gophercloud.EndpointOpts{}, err
+	}
+
+	return cloud.EndpointOpts()
+}
+
+// EndpointOpts returns the gophercloud.EndpointOpts for the cloud.
+func (cloud *Cloud) EndpointOpts() (gophercloud.EndpointOpts, error) {
+	eo := gophercloud.EndpointOpts{
+		Region: cloud.RegionName,
+	}
+
+	iface := cloud.Interface
+	if iface == "" {
+		iface = cloud.EndpointType
+	}
+	switch strings.TrimSuffix(iface, "URL") {
+	case "":
+	case "public":
+		eo.Availability = gophercloud.AvailabilityPublic
+	case "internal":
+		eo.Availability = gophercloud.AvailabilityInternal
+	case "admin":
+		eo.Availability = gophercloud.AvailabilityAdmin
+	default:
+		err := gophercloud.ErrInvalidInput{}
+		err.Argument = "interface"
+		err.Value = iface
+		return eo, err
+	}
+
+	return eo, nil
+}
+
+// TLSConfig returns the TLS settings of the cloud selected by opts. It
+// returns nil if the cloud uses the default settings.
+func TLSConfig(opts *ClientOpts) (*tls.Config, error) {
+	cloud, err := GetCloudFromYAML(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return cloud.TLSConfig()
+}
+
+// TLSConfig returns the TLS settings for the cloud, or nil if the cloud uses
+// the default settings.
+func (cloud *Cloud) TLSConfig() (*tls.Config, error) {
+	if cloud.Verify == nil && cloud.CACertFile == "" && cloud.ClientCertFile == "" {
+		return nil, nil
+	}
+
+	config := new(tls.Config)
+
+	if cloud.Verify != nil && !*cloud.Verify {
+		config.InsecureSkipVerify = true
+	}
+
+	if cloud.CACertFile != "" {
+		pem, err := ioutil.ReadFile(cloud.CACertFile)
+		if err != nil {
+			return nil, err
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("No certificates could be read from [%s]", cloud.CACertFile)
+		}
+		config.RootCAs = pool
+	}
+
+	if cloud.ClientCertFile != "" {
+		cert, err := tls.LoadX509KeyPair(cloud.ClientCertFile, cloud.ClientKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+
+	return config, nil
+}
+
+// AuthenticatedClient returns a ProviderClient authenticated against the
+// cloud selected by opts, using its TLS settings.
+func AuthenticatedClient(opts *ClientOpts) (*gophercloud.ProviderClient, error) {
+	cloud, err := GetCloudFromYAML(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return cloud.AuthenticatedClient()
+}
+
+// AuthenticatedClient returns a ProviderClient authenticated against the
+// cloud, using its TLS settings.
+func (cloud *Cloud) AuthenticatedClient() (*gophercloud.ProviderClient, error) {
+	ao, err := cloud.AuthOptions()
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig, err := cloud.TLSConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := openstack.NewClient(ao.IdentityEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if tlsConfig != nil {
+		client.HTTPClient.Transport = &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: tlsConfig,
+		}
+	}
+
+	err = openstack.Authenticate(client, *ao)
+	if err != nil {
+		return nil, err
+	}
+
+	return client, nil
+}
+
+// NewServiceClient returns an authenticated ServiceClient for the given
+// service type, e.g. "compute" or "network", of the cloud selected by opts.
+func NewServiceClient(service string, opts *ClientOpts) (*gophercloud.ServiceClient, error) {
+	cloud, err := GetCloudFromYAML(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := cloud.AuthenticatedClient()
+	if err != nil {
+		return nil, err
+	}
+
+	eo, err := cloud.EndpointOpts()
+	if err != nil {
+		return nil, err
+	}
+
+	switch service {
+	case "clustering":
+		return openstack.NewClusteringV1(client, eo)
+	case "compute":
+		return openstack.NewComputeV2(client, eo)
+	case "container":
+		return openstack.NewContainerV1(client, eo)
+	case "database":
+		return openstack.NewDBV1(client, eo)
+	case "dns":
+		return openstack.NewDNSV2(client, eo)
+	case "identity":
+		if strings.HasPrefix(cloud.IdentityAPIVersion, "2") {
+			return openstack.NewIdentityV2(client, eo)
+		}
+		return openstack.NewIdentityV3(client, eo)
+	case "image":
+		return openstack.NewImageServiceV2(client, eo)
+	case "load-balancer":
+		return openstack.NewLoadBalancerV2(client, eo)
+	case "network":
+		return openstack.NewNetworkV2(client, eo)
+	case "object-store":
+		return openstack.NewObjectStorageV1(client, eo)
+	case "orchestration":
+		return openstack.NewOrchestrationV1(client, eo)
+	case "sharev2":
+		return openstack.NewSharedFileSystemV2(client, eo)
+	case "volume", "volumev3":
+		return openstack.NewBlockStorageV3(client, eo)
+	case "volumev2":
+		return openstack.NewBlockStorageV2(client, eo)
+	}
+
+	e := gophercloud.ErrInvalidInput{}
+	e.Argument = "service"
+	e.Value = service
+	return nil, e
+}
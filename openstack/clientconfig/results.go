@@ -0,0 +1,76 @@
+package clientconfig
+
+// Cloud represents an entry in a clouds.yaml, secure.yaml or
+// clouds-public.yaml file.
+type Cloud struct {
+	// Profile is the name of a vendor profile from clouds-public.yaml whose
+	// settings are used as defaults for this cloud.
+	Profile string `yaml:"profile,omitempty"`
+
+	// AuthInfo holds the authentication settings of the cloud.
+	AuthInfo *AuthInfo `yaml:"auth,omitempty"`
+
+	// AuthType is the authentication method, e.g. "password" or "token".
+	AuthType string `yaml:"auth_type,omitempty"`
+
+	// RegionName is the region to use.
+	RegionName string `yaml:"region_name,omitempty"`
+
+	// Interface is the endpoint interface to use: "public", "internal" or
+	// "admin". EndpointType is the legacy name of the same setting.
+	Interface    string `yaml:"interface,omitempty"`
+	EndpointType string `yaml:"endpoint_type,omitempty"`
+
+	// IdentityAPIVersion is the version of the Identity API, e.g. "3".
+	IdentityAPIVersion string `yaml:"identity_api_version,omitempty"`
+
+	// Verify controls whether the server's TLS certificate is verified.
+	// Defaults to true.
+	Verify *bool `yaml:"verify,omitempty"`
+
+	// CACertFile is the path to a CA bundle used to verify the server's TLS
+	// certificate.
+	CACertFile string `yaml:"cacert,omitempty"`
+
+	// ClientCertFile and ClientKeyFile are the paths to a client TLS
+	// certificate and its private key.
+	ClientCertFile string `yaml:"cert,omitempty"`
+	ClientKeyFile  string `yaml:"key,omitempty"`
+}
+
+// AuthInfo represents the "auth" section of a cloud.
+type AuthInfo struct {
+	AuthURL  string `yaml:"auth_url,omitempty"`
+	Token    string `yaml:"token,omitempty"`
+	Username string `yaml:"username,omitempty"`
+	UserID   string `yaml:"user_id,omitempty"`
+	Password string `yaml:"password,omitempty"`
+
+	ProjectName string `yaml:"project_name,omitempty"`
+	ProjectID   string `yaml:"project_id,omitempty"`
+
+	UserDomainName    string `yaml:"user_domain_name,omitempty"`
+	UserDomainID      string `yaml:"user_domain_id,omitempty"`
+	ProjectDomainName string `yaml:"project_domain_name,omitempty"`
+	ProjectDomainID   string `yaml:"project_domain_id,omitempty"`
+
+	// DomainName and DomainID scope the token to a domain when no project is
+	// given.
+	DomainName string `yaml:"domain_name,omitempty"`
+	DomainID   string `yaml:"domain_id,omitempty"`
+
+	// SystemScope scopes the token to the whole deployment when set to
+	// "all". It takes precedence over the project and the domain.
+	SystemScope string `yaml:"system_scope,omitempty"`
+
+	// ApplicationCredentialID or ApplicationCredentialName, together with
+	// ApplicationCredentialSecret, authenticate with an application
+	// credential.
+	ApplicationCredentialID     string `yaml:"application_credential_id,omitempty"`
+	ApplicationCredentialName   string `yaml:"application_credential_name,omitempty"`
+	ApplicationCredentialSecret string `yaml:"application_credential_secret,omitempty"`
+
+	// DefaultDomain is the ID of the domain used for the user and the project
+	// when no other domain is given.
+	DefaultDomain string `yaml:"default_domain,omitempty"`
+}
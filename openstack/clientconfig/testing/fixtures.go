@@ -0,0 +1,93 @@
+package testing
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+// CloudsYAML is a clouds.yaml file with a cloud using a vendor profile, a
+// standalone cloud, and a system-scoped cloud.
+const CloudsYAML = `
+clouds:
+  vendored:
+    profile: example
+    auth:
+      username: jdoe
+      project_name: demo
+    region_name: RegionTwo
+  standalone:
+    auth:
+      auth_url: https://keystone.example.com:5000/v3
+      user_id: 5a2c4eb7
+      project_id: 7e94d41b
+    identity_api_version: "3"
+    interface: internal
+    verify: false
+  system:
+    profile: example
+    auth:
+      username: admin
+      password: s3cr3t
+      project_name: admin
+      system_scope: all
+`
+
+// SecureYAML holds the password of the vendored cloud.
+const SecureYAML = `
+clouds:
+  vendored:
+    auth:
+      password: s3cr3t
+`
+
+// PublicCloudsYAML holds the example vendor profile.
+const PublicCloudsYAML = `
+public-clouds:
+  example:
+    auth:
+      auth_url: https://identity.example.org/v3
+      user_domain_name: Default
+      project_domain_id: default
+    region_name: RegionOne
+    identity_api_version: "3"
+    interface: public
+`
+
+// SetupConfigDir writes the fixtures in a temporary directory, points the
+// search path of clientconfig to it and returns a function undoing it.
+func SetupConfigDir(t *testing.T) func() {
+	dir, err := ioutil.TempDir("", "clientconfig")
+	th.AssertNoErr(t, err)
+
+	files := map[string]string{
+		"clouds.yaml":        CloudsYAML,
+		"secure.yaml":        SecureYAML,
+		"clouds-public.yaml": PublicCloudsYAML,
+	}
+	for name, content := range files {
+		th.AssertNoErr(t, ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0600))
+	}
+
+	cwd, err := os.Getwd()
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, os.Chdir(dir))
+
+	env := map[string]string{}
+	for _, k := range []string{"OS_CLOUD", "OS_CLIENT_CONFIG_FILE", "OS_CLIENT_SECURE_FILE", "XDG_CONFIG_HOME"} {
+		env[k] = os.Getenv(k)
+		os.Unsetenv(k)
+	}
+	os.Setenv("XDG_CONFIG_HOME", dir)
+
+	return func() {
+		os.Chdir(cwd)
+		os.RemoveAll(dir)
+		for k, v := range env {
+			os.Setenv(k, v)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package testing
+
+import (
+	"os"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/clientconfig"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestGetCloudFromYAMLWithProfile(t *testing.T) {
+	defer SetupConfigDir(t)()
+
+	cloud, err := clientconfig.GetCloudFromYAML(&clientconfig.ClientOpts{Cloud: "vendored"})
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, "https://identity.example.org/v3", cloud.AuthInfo.AuthURL)
+	th.AssertEquals(t, "jdoe", cloud.AuthInfo.Username)
+	th.AssertEquals(t, "s3cr3t", cloud.AuthInfo.Password)
+	th.AssertEquals(t, "Default", cloud.AuthInfo.UserDomainName)
+	th.AssertEquals(t, "RegionTwo", cloud.RegionName)
+	th.AssertEquals(t, "public", cloud.Interface)
+}
+
+func TestGetCloudFromYAMLFromEnv(t *testing.T) {
+	defer SetupConfigDir(t)()
+
+	_, err := clientconfig.GetCloudFromYAML(nil)
+	if _, ok := err.(gophercloud.ErrMissingEnvironmentVariable); !ok {
+		t.Fatalf("expected ErrMissingEnvironmentVariable, got %T: %v", err, err)
+	}
+
+	os.Setenv("OS_CLOUD", "standalone")
+	cloud, err := clientconfig.GetCloudFromYAML(nil)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "5a2c4eb7", cloud.AuthInfo.UserID)
+
+	os.Setenv("OS_CLOUD", "missing")
+	_, err = clientconfig.GetCloudFromYAML(nil)
+	if _, ok := err.(clientconfig.ErrCloudNotFound); !ok {
+		t.Fatalf("expected ErrCloudNotFound, got %T: %v", err, err)
+	}
+}
+
+func TestAuthOptions(t *testing.T) {
+	defer SetupConfigDir(t)()
+
+	ao, err := clientconfig.AuthOptions(&clientconfig.ClientOpts{Cloud: "vendored"})
+	th.AssertNoErr(t, err)
+
+	expected := &gophercloud.AuthOptions{
+		IdentityEndpoint: "https://identity.example.org/v3",
+		Username:         "jdoe",
+		Password:         "s3cr3t",
+		DomainName:       "Default",
+		AllowReauth:      true,
+		Scope: &gophercloud.AuthScope{
+			ProjectName: "demo",
+			DomainID:    "default",
+		},
+	}
+	th.AssertDeepEquals(t, expected, ao)
+
+	ao, err = clientconfig.AuthOptions(&clientconfig.ClientOpts{Cloud: "standalone"})
+	th.AssertNoErr(t, err)
+
+	expected = &gophercloud.AuthOptions{
+		IdentityEndpoint: "https://keystone.example.com:5000/v3",
+		UserID:           "5a2c4eb7",
+		AllowReauth:      true,
+		Scope: &gophercloud.AuthScope{
+			ProjectID: "7e94d41b",
+		},
+	}
+	th.AssertDeepEquals(t, expected, ao)
+
+	ao, err = clientconfig.AuthOptions(&clientconfig.ClientOpts{Cloud: "system"})
+	th.AssertNoErr(t, err)
+
+	expected = &gophercloud.AuthOptions{
+		IdentityEndpoint: "https://identity.example.org/v3",
+		Username:         "admin",
+		Password:         "s3cr3t",
+		DomainName:       "Default",
+		AllowReauth:      true,
+		Scope: &gophercloud.AuthScope{
+			System: true,
+		},
+	}
+	th.AssertDeepEquals(t, expected, ao)
+}
+
+func TestEndpointOpts(t *testing.T) {
+	defer SetupConfigDir(t)()
+
+	eo, err := clientconfig.EndpointOpts(&clientconfig.ClientOpts{Cloud: "vendored"})
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, gophercloud.EndpointOpts{
+		Region:       "RegionTwo",
+		Availability: gophercloud.AvailabilityPublic,
+	}, eo)
+
+	eo, err = clientconfig.EndpointOpts(&clientconfig.ClientOpts{Cloud: "standalone", RegionName: "RegionThree"})
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, gophercloud.EndpointOpts{
+		Region:       "RegionThree",
+		Availability: gophercloud.AvailabilityInternal,
+	}, eo)
+}
+
+func TestTLSConfig(t *testing.T) {
+	defer SetupConfigDir(t)()
+
+	config, err := clientconfig.TLSConfig(&clientconfig.ClientOpts{Cloud: "vendored"})
+	th.AssertNoErr(t, err)
+	if config != nil {
+		t.Fatalf("expected no TLS config, got %+v", config)
+	}
+
+	config, err = clientconfig.TLSConfig(&clientconfig.ClientOpts{Cloud: "standalone"})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, config.InsecureSkipVerify)
+}
@@ -0,0 +1,130 @@
+package clientconfig
+
+import (
+	"io/ioutil"
+	"os"
+	"os/user"
+	"path/filepath"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// configSearchPath returns the directories searched for configuration files,
+// in order of precedence.
+func configSearchPath() []string {
+	var dirs []string
+
+	if cwd, err := os.Getwd(); err == nil {
+		dirs = append(dirs, cwd)
+	}
+
+	if xdg := os.Getenv("XDG_CONFIG_HOME"); xdg != "" {
+		dirs = append(dirs, filepath.Join(xdg, "openstack"))
+	} else if home := homeDir(); home != "" {
+		dirs = append(dirs, filepath.Join(home, ".config", "openstack"))
+	}
+
+	return append(dirs, "/etc/openstack")
+}
+
+// homeDir returns the home directory of the current user, or an empty string
+// if it is unknown.
+func homeDir() string {
+	if home := os.Getenv("HOME"); home != "" {
+		return home
+	}
+	if u, err := user.Current(); err == nil {
+		return u.HomeDir
+	}
+	return ""
+}
+
+// findConfigFile returns the path of the first file with one of the given
+// names in the search path. If envVar is set, its value is returned instead.
+// An empty string is returned if no file was found.
+func findConfigFile(envVar string, names ...string) string {
+	if v := os.Getenv(envVar); v != "" {
+		return v
+	}
+
+	for _, dir := range configSearchPath() {
+		for _, name := range names {
+			p := filepath.Join(dir, name)
+			if _, err := os.Stat(p); err == nil {
+				return p
+			}
+		}
+	}
+
+	return ""
+}
+
+// loadClouds reads the clouds found under key in the first file found by
+// findConfigFile. A nil map is returned if there is no such file.
+func loadClouds(key, envVar string, names ...string) (map[interface{}]interface{}, error) {
+	path := findConfigFile(envVar, names...)
+	if path == "" {
+		return nil, nil
+	}
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc map[interface{}]interface{}
+	if err := yaml.Unmarshal(content, &doc); err != nil {
+		return nil, err
+	}
+
+	clouds, _ := doc[key].(map[interface{}]interface{})
+	return clouds, nil
+}
+
+// LoadCloudsYAML reads the clouds defined in clouds.yaml. Settings from
+// secure.yaml and clouds-public.yaml are not applied; use GetCloudFromYAML
+// for that.
+func LoadCloudsYAML() (map[string]Cloud, error) {
+	raw, err := loadClouds("clouds", "OS_CLIENT_CONFIG_FILE", "clouds.yaml", "clouds.yml")
+	if err != nil {
+		return nil, err
+	}
+
+	var clouds map[string]Cloud
+	if err := decodeCloud(raw, &clouds); err != nil {
+		return nil, err
+	}
+
+	return clouds, nil
+}
+
+// mergeCloud returns a copy of base overridden by the values of override.
+// Nested maps, like "auth", are merged key by key.
+func mergeCloud(base, override map[interface{}]interface{}) map[interface{}]interface{} {
+	merged := make(map[interface{}]interface{}, len(base)+len(override))
+	for k, v := range base {
+		merged[k] = v
+	}
+
+	for k, v := range override {
+		vm, vok := v.(map[interface{}]interface{})
+		bm, bok := merged[k].(map[interface{}]interface{})
+		if vok && bok {
+			merged[k] = mergeCloud(bm, vm)
+			continue
+		}
+		merged[k] = v
+	}
+
+	return merged
+}
+
+// decodeCloud converts a raw YAML value into one of the types of this
+// package.
+func decodeCloud(raw interface{}, v interface{}) error {
+	b, err := yaml.Marshal(raw)
+	if err != nil {
+		return err
+	}
+	return yaml.Unmarshal(b, v)
+}
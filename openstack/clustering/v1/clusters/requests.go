@@ -440,7 +440,8 @@ func ListPolicies(client *gophercloud.ServiceClient, clusterID string, opts List
 
 // GetPolicy retrieves details of a cluster policy.
 func GetPolicy(client *gophercloud.ServiceClient, clusterID string, policyID string) (r GetPolicyResult) {
-	_, r.Err = client.Get(getPolicyURL(client, clusterID, policyID), &r.Body, nil)
+	resp, err := client.Get(getPolicyURL(client, clusterID, policyID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
 
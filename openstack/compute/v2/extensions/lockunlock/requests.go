@@ -8,12 +8,14 @@ func actionURL(client *gophercloud.ServiceClient, id string) string {
 
 // Lock is the operation responsible for locking a Compute server.
 func Lock(client *gophercloud.ServiceClient, id string) (r LockResult) {
-	_, r.Err = client.Post(actionURL(client, id), map[string]interface{}{"lock": nil}, nil, nil)
+	resp, err := client.Post(actionURL(client, id), map[string]interface{}{"lock": nil}, nil, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
 
 // Unlock is the operation responsible for unlocking a Compute server.
 func Unlock(client *gophercloud.ServiceClient, id string) (r UnlockResult) {
-	_, r.Err = client.Post(actionURL(client, id), map[string]interface{}{"unlock": nil}, nil, nil)
+	resp, err := client.Post(actionURL(client, id), map[string]interface{}{"unlock": nil}, nil, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
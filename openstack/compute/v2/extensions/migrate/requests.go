@@ -6,7 +6,8 @@ import (
 
 // Migrate will initiate a migration of the instance to another host.
 func Migrate(client *gophercloud.ServiceClient, id string) (r MigrateResult) {
-	_, r.Err = client.Post(actionURL(client, id), map[string]interface{}{"migrate": nil}, nil, nil)
+	resp, err := client.Post(actionURL(client, id), map[string]interface{}{"migrate": nil}, nil, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
 
@@ -45,6 +46,7 @@ func LiveMigrate(client *gophercloud.ServiceClient, id string, opts LiveMigrateO
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(actionURL(client, id), b, nil, nil)
+	resp, err := client.Post(actionURL(client, id), b, nil, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
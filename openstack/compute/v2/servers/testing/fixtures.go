@@ -801,6 +801,20 @@ func HandleServerGetSuccessfully(t *testing.T) {
 		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
 		th.TestHeader(t, r, "Accept", "application/json")
 
+		w.Header().Set("X-Compute-Request-Id", "req-3b6e4ea8-8d4e-4e4b-9f33-3e0e7c2b71c4")
+		fmt.Fprintf(w, SingleServerBody)
+	})
+}
+
+// HandleServerGetWithGlobalRequestIDSuccessfully sets up the test server to
+// respond to a server Get request which carries the given global request ID.
+func HandleServerGetWithGlobalRequestIDSuccessfully(t *testing.T, requestID string) {
+	th.Mux.HandleFunc("/servers/1234asdf", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestHeader(t, r, "X-OpenStack-Request-ID", requestID)
+
+		w.Header().Set("X-Compute-Request-Id", "req-3b6e4ea8-8d4e-4e4b-9f33-3e0e7c2b71c4")
 		fmt.Fprintf(w, SingleServerBody)
 	})
 }
@@ -218,6 +218,17 @@ func TestGetServer(t *testing.T) {
 	th.CheckDeepEquals(t, ServerDerp, *actual)
 }
 
+func TestGetServerRequestID(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServerGetWithGlobalRequestIDSuccessfully(t, "req-a5c8e1f0-1f0e-4a0e-9c4a-2f6f1d2b3c4d")
+
+	client := client.ServiceClient()
+	result := servers.Get(client.WithGlobalRequestID("req-a5c8e1f0-1f0e-4a0e-9c4a-2f6f1d2b3c4d"), "1234asdf")
+	th.AssertNoErr(t, result.Err)
+	th.AssertEquals(t, "req-3b6e4ea8-8d4e-4e4b-9f33-3e0e7c2b71c4", result.RequestID())
+}
+
 func TestGetFaultyServer(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
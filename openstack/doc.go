@@ -10,5 +10,23 @@ Example of Creating a Service Client
 	client, err := openstack.NewNetworkV2(client, gophercloud.EndpointOpts{
 		Region: os.Getenv("OS_REGION_NAME"),
 	})
+
+Example of Listing Resources in Every Region
+
+	results, err := openstack.ForEachRegion(provider, openstack.RegionOpts{
+		EndpointOpts: gophercloud.EndpointOpts{Type: "volumev3"},
+		NewClient:    openstack.NewBlockStorageV3,
+		MaxParallel:  8,
+	}, func(client *gophercloud.ServiceClient) (interface{}, error) {
+		allPages, err := volumes.List(client, nil).AllPages()
+		if err != nil {
+			return nil, err
+		}
+		return volumes.ExtractVolumes(allPages)
+	})
+
+	for region, err := range results.Errors() {
+		log.Printf("listing volumes in %s failed: %v", region, err)
+	}
 */
 package openstack
@@ -15,6 +15,9 @@ to return. It's an error both when multiple endpoints match the provided
 criteria and when none do. The minimum that can be specified is a Type, but you
 will also often need to specify a Name and/or a Region depending on what's
 available on your OpenStack deployment.
+
+If the matching endpoint has no URL for the requested Availability, the
+availabilities of AvailabilityFallbacks are tried in order.
 */
 func V2EndpointURL(catalog *tokens2.ServiceCatalog, opts gophercloud.EndpointOpts) (string, error) {
 	// Extract Endpoints from the catalog entries that match the requested Type, Name if provided, and Region if provided.
@@ -38,18 +41,25 @@ func V2EndpointURL(catalog *tokens2.ServiceCatalog, opts gophercloud.EndpointOpt
 
 	// Extract the appropriate URL from the matching Endpoint.
 	for _, endpoint := range endpoints {
-		switch opts.Availability {
-		case gophercloud.AvailabilityPublic:
-			return gophercloud.NormalizeURL(endpoint.PublicURL), nil
-		case gophercloud.AvailabilityInternal:
-			return gophercloud.NormalizeURL(endpoint.InternalURL), nil
-		case gophercloud.AvailabilityAdmin:
-			return gophercloud.NormalizeURL(endpoint.AdminURL), nil
-		default:
-			err := &ErrInvalidAvailabilityProvided{}
-			err.Argument = "Availability"
-			err.Value = opts.Availability
-			return "", err
+		availabilities := opts.Availabilities()
+		for i, availability := range availabilities {
+			var url string
+			switch availability {
+			case gophercloud.AvailabilityPublic:
+				url = endpoint.PublicURL
+			case gophercloud.AvailabilityInternal:
+				url = endpoint.InternalURL
+			case gophercloud.AvailabilityAdmin:
+				url = endpoint.AdminURL
+			default:
+				err := &ErrInvalidAvailabilityProvided{}
+				err.Argument = "Availability"
+				err.Value = availability
+				return "", err
+			}
+			if url != "" || i == len(availabilities)-1 {
+				return gophercloud.NormalizeURL(url), nil
+			}
 		}
 	}
 
@@ -67,8 +77,25 @@ to return. It's an error both when multiple endpoints match the provided
 criteria and when none do. The minimum that can be specified is a Type, but you
 will also often need to specify a Name and/or a Region depending on what's
 available on your OpenStack deployment.
+
+If no endpoint of the requested Availability matches, the availabilities of
+AvailabilityFallbacks are tried in order.
 */
 func V3EndpointURL(catalog *tokens3.ServiceCatalog, opts gophercloud.EndpointOpts) (string, error) {
+	availabilities := opts.Availabilities()
+	for i, availability := range availabilities {
+		o := opts
+		o.Availability = availability
+		url, err := v3EndpointURL(catalog, o)
+		if _, ok := err.(*gophercloud.ErrEndpointNotFound); ok && i < len(availabilities)-1 {
+			continue
+		}
+		return url, err
+	}
+	return "", &gophercloud.ErrEndpointNotFound{}
+}
+
+func v3EndpointURL(catalog *tokens3.ServiceCatalog, opts gophercloud.EndpointOpts) (string, error) {
 	// Extract Endpoints from the catalog entries that match the requested Type, Interface,
 	// Name if provided, and Region if provided.
 	var endpoints = make([]tokens3.Endpoint, 0, 1)
@@ -105,3 +132,58 @@ func V3EndpointURL(catalog *tokens3.ServiceCatalog, opts gophercloud.EndpointOpt
 	err := &gophercloud.ErrEndpointNotFound{}
 	return "", err
 }
+
+// V2ServiceCatalog converts a ServiceCatalog acquired from the v2 identity
+// service into a gophercloud.ServiceCatalog. Each URL of an endpoint becomes a
+// separate CatalogEndpoint.
+func V2ServiceCatalog(catalog *tokens2.ServiceCatalog) *gophercloud.ServiceCatalog {
+	c := &gophercloud.ServiceCatalog{}
+	for _, entry := range catalog.Entries {
+		for _, endpoint := range entry.Endpoints {
+			for _, u := range []struct {
+				availability gophercloud.Availability
+				url          string
+			}{
+				{gophercloud.AvailabilityPublic, endpoint.PublicURL},
+				{gophercloud.AvailabilityInternal, endpoint.InternalURL},
+				{gophercloud.AvailabilityAdmin, endpoint.AdminURL},
+			} {
+				if u.url == "" {
+					continue
+				}
+				c.Endpoints = append(c.Endpoints, gophercloud.CatalogEndpoint{
+					ServiceName:  entry.Name,
+					ServiceType:  entry.Type,
+					Region:       endpoint.Region,
+					Availability: u.availability,
+					URL:          gophercloud.NormalizeURL(u.url),
+				})
+			}
+		}
+	}
+	return c
+}
+
+// V3ServiceCatalog converts a ServiceCatalog acquired from the v3 identity
+// service into a gophercloud.ServiceCatalog.
+func V3ServiceCatalog(catalog *tokens3.ServiceCatalog) *gophercloud.ServiceCatalog {
+	c := &gophercloud.ServiceCatalog{}
+	for _, entry := range catalog.Entries {
+		for _, endpoint := range entry.Endpoints {
+			region := endpoint.RegionID
+			if region == "" {
+				region = endpoint.Region
+			}
+			c.Endpoints = append(c.Endpoints, gophercloud.CatalogEndpoint{
+				ServiceID:    entry.ID,
+				ServiceName:  entry.Name,
+				ServiceType:  entry.Type,
+				ID:           endpoint.ID,
+				Region:       region,
+				Availability: gophercloud.Availability(endpoint.Interface),
+				URL:          gophercloud.NormalizeURL(endpoint.URL),
+			})
+		}
+	}
+	return c
+}
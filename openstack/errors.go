@@ -16,6 +16,27 @@ func (e ErrEndpointNotFound) Error() string {
 	return "No suitable endpoint could be found in the service catalog."
 }
 
+// ErrServiceCatalogUnavailable is the error when the service catalog of a
+// provider client is needed but unknown, e.g. because the client wasn't
+// authenticated by this package.
+type ErrServiceCatalogUnavailable struct{ gophercloud.BaseError }
+
+func (e ErrServiceCatalogUnavailable) Error() string {
+	return "The service catalog of the provider client is unknown."
+}
+
+// ErrEndpointOverridden is the error when a service would be called in
+// several regions, but its endpoint is overridden in the EndpointOverrides of
+// the provider client, so that every region would use the same endpoint.
+type ErrEndpointOverridden struct {
+	gophercloud.BaseError
+	ServiceType string
+}
+
+func (e ErrEndpointOverridden) Error() string {
+	return fmt.Sprintf("The endpoint of the %s service is overridden in every region.", e.ServiceType)
+}
+
 // ErrInvalidAvailabilityProvided is the error when an invalid endpoint
 // availability is provided
 type ErrInvalidAvailabilityProvided struct{ gophercloud.ErrInvalidInput }
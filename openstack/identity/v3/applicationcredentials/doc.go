@@ -0,0 +1,71 @@
+/*
+Package applicationcredentials provides information and interaction with the
+application credentials API resource for the OpenStack Identity service.
+
+Example to List Application Credentials
+
+	listOpts := applicationcredentials.ListOpts{
+		Name: "my_app_cred",
+	}
+
+	allPages, err := applicationcredentials.List(identityClient, userID, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allApplicationCredentials, err := applicationcredentials.ExtractApplicationCredentials(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, applicationCredential := range allApplicationCredentials {
+		fmt.Printf("%+v\n", applicationCredential)
+	}
+
+Example to Get an Application Credential
+
+	applicationCredential, err := applicationcredentials.Get(identityClient, userID, applicationCredentialID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Create an Application Credential
+
+	createOpts := applicationcredentials.CreateOpts{
+		Name:        "test-ac",
+		Description: "description",
+		Roles: []applicationcredentials.Role{
+			applicationcredentials.Role{Name: "admin"},
+		},
+	}
+
+	applicationCredential, err := applicationcredentials.Create(identityClient, userID, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	// The secret is only returned on creation.
+	fmt.Println(applicationCredential.Secret)
+
+Example to Authenticate with an Application Credential
+
+	authOpts := gophercloud.AuthOptions{
+		IdentityEndpoint:            "https://openstack.example.com:5000/v3",
+		ApplicationCredentialID:     applicationCredential.ID,
+		ApplicationCredentialSecret: applicationCredential.Secret,
+		AllowReauth:                 true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(authOpts)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete an Application Credential
+
+	err := applicationcredentials.Delete(identityClient, userID, applicationCredentialID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package applicationcredentials
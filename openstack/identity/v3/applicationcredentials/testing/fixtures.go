@@ -0,0 +1,258 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/applicationcredentials"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+const userID = "2844b2a08be147a08ef58317d6471f1f"
+const applicationCredentialID = "f741662395b249c9b8acdebf1722c5ae"
+
+// ListOutput provides a single page of ApplicationCredential results.
+const ListOutput = `
+{
+  "links": {
+    "self": "http://identity/v3/users/2844b2a08be147a08ef58317d6471f1f/application_credentials",
+    "previous": null,
+    "next": null
+  },
+  "application_credentials": [
+    {
+      "links": {
+        "self": "http://identity/v3/users/2844b2a08be147a08ef58317d6471f1f/application_credentials/c4859fb437df4b87a51a8f5adcfb0bc7"
+      },
+      "description": "",
+      "roles": [
+        {
+          "domain_id": null,
+          "name": "admin",
+          "id": "31f87923ae4a4d119aa0b85dcdbeed13"
+        }
+      ],
+      "expires_at": null,
+      "unrestricted": false,
+      "project_id": "53c2b94f63fb4f43a21b92d119ce549f",
+      "id": "c4859fb437df4b87a51a8f5adcfb0bc7",
+      "name": "test"
+    },
+    {
+      "links": {
+        "self": "http://identity/v3/users/2844b2a08be147a08ef58317d6471f1f/application_credentials/6b8cc7647da64166a4a3cc0c88ebbabb"
+      },
+      "description": "test application credentials",
+      "roles": [
+        {
+          "domain_id": null,
+          "name": "member",
+          "id": "4494bc5bea1a4105ad7fbba6a7eb9ef4"
+        }
+      ],
+      "expires_at": "2019-03-12T12:12:12.123456",
+      "unrestricted": true,
+      "project_id": "53c2b94f63fb4f43a21b92d119ce549f",
+      "id": "6b8cc7647da64166a4a3cc0c88ebbabb",
+      "name": "test2"
+    }
+  ]
+}
+`
+
+// GetOutput provides a Get result.
+const GetOutput = `
+{
+  "application_credential": {
+    "links": {
+      "self": "http://identity/v3/users/2844b2a08be147a08ef58317d6471f1f/application_credentials/f741662395b249c9b8acdebf1722c5ae"
+    },
+    "description": "",
+    "roles": [
+      {
+        "domain_id": null,
+        "name": "admin",
+        "id": "31f87923ae4a4d119aa0b85dcdbeed13"
+      }
+    ],
+    "expires_at": null,
+    "unrestricted": false,
+    "project_id": "53c2b94f63fb4f43a21b92d119ce549f",
+    "id": "f741662395b249c9b8acdebf1722c5ae",
+    "name": "test"
+  }
+}
+`
+
+// CreateRequest provides the input to a Create request.
+const CreateRequest = `
+{
+  "application_credential": {
+    "name": "test",
+    "secret": "mysecret",
+    "unrestricted": false,
+    "expires_at": "2019-03-12T12:12:12.123456",
+    "roles": [
+      {
+        "id": "31f87923ae4a4d119aa0b85dcdbeed13"
+      }
+    ]
+  }
+}
+`
+
+// CreateResponse provides a Create result.
+const CreateResponse = `
+{
+  "application_credential": {
+    "links": {
+      "self": "http://identity/v3/users/2844b2a08be147a08ef58317d6471f1f/application_credentials/f741662395b249c9b8acdebf1722c5ae"
+    },
+    "description": "",
+    "roles": [
+      {
+        "domain_id": null,
+        "name": "admin",
+        "id": "31f87923ae4a4d119aa0b85dcdbeed13"
+      }
+    ],
+    "expires_at": "2019-03-12T12:12:12.123456",
+    "secret": "mysecret",
+    "unrestricted": false,
+    "project_id": "53c2b94f63fb4f43a21b92d119ce549f",
+    "id": "f741662395b249c9b8acdebf1722c5ae",
+    "name": "test"
+  }
+}
+`
+
+var nilTime time.Time
+var ApplicationCredentialExpiresAt = time.Date(2019, 3, 12, 12, 12, 12, 123456000, time.UTC)
+
+var FirstApplicationCredential = applicationcredentials.ApplicationCredential{
+	ID:          "c4859fb437df4b87a51a8f5adcfb0bc7",
+	Name:        "test",
+	Description: "",
+	Roles: []applicationcredentials.Role{
+		{
+			ID:   "31f87923ae4a4d119aa0b85dcdbeed13",
+			Name: "admin",
+		},
+	},
+	ExpiresAt: nilTime,
+	ProjectID: "53c2b94f63fb4f43a21b92d119ce549f",
+	Links: map[string]interface{}{
+		"self": "http://identity/v3/users/2844b2a08be147a08ef58317d6471f1f/application_credentials/c4859fb437df4b87a51a8f5adcfb0bc7",
+	},
+}
+
+var SecondApplicationCredential = applicationcredentials.ApplicationCredential{
+	ID:           "6b8cc7647da64166a4a3cc0c88ebbabb",
+	Name:         "test2",
+	Description:  "test application credentials",
+	Unrestricted: true,
+	Roles: []applicationcredentials.Role{
+		{
+			ID:   "4494bc5bea1a4105ad7fbba6a7eb9ef4",
+			Name: "member",
+		},
+	},
+	ExpiresAt: ApplicationCredentialExpiresAt,
+	ProjectID: "53c2b94f63fb4f43a21b92d119ce549f",
+	Links: map[string]interface{}{
+		"self": "http://identity/v3/users/2844b2a08be147a08ef58317d6471f1f/application_credentials/6b8cc7647da64166a4a3cc0c88ebbabb",
+	},
+}
+
+var ApplicationCredential = applicationcredentials.ApplicationCredential{
+	ID:          "f741662395b249c9b8acdebf1722c5ae",
+	Name:        "test",
+	Description: "",
+	Roles: []applicationcredentials.Role{
+		{
+			ID:   "31f87923ae4a4d119aa0b85dcdbeed13",
+			Name: "admin",
+		},
+	},
+	ExpiresAt: nilTime,
+	ProjectID: "53c2b94f63fb4f43a21b92d119ce549f",
+	Links: map[string]interface{}{
+		"self": "http://identity/v3/users/2844b2a08be147a08ef58317d6471f1f/application_credentials/f741662395b249c9b8acdebf1722c5ae",
+	},
+}
+
+var ApplicationCredentialResponse = applicationcredentials.ApplicationCredential{
+	ID:          "f741662395b249c9b8acdebf1722c5ae",
+	Name:        "test",
+	Description: "",
+	Roles: []applicationcredentials.Role{
+		{
+			ID:   "31f87923ae4a4d119aa0b85dcdbeed13",
+			Name: "admin",
+		},
+	},
+	ExpiresAt: ApplicationCredentialExpiresAt,
+	ProjectID: "53c2b94f63fb4f43a21b92d119ce549f",
+	Secret:    "mysecret",
+	Links: map[string]interface{}{
+		"self": "http://identity/v3/users/2844b2a08be147a08ef58317d6471f1f/application_credentials/f741662395b249c9b8acdebf1722c5ae",
+	},
+}
+
+// ExpectedApplicationCredentialsSlice is the slice of application credentials expected to be returned from ListOutput.
+var ExpectedApplicationCredentialsSlice = []applicationcredentials.ApplicationCredential{FirstApplicationCredential, SecondApplicationCredential}
+
+// HandleListApplicationCredentialsSuccessfully creates an HTTP handler at `/users/{user_id}/application_credentials` on the
+// test handler mux that responds with a list of two applicationcredentials.
+func HandleListApplicationCredentialsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/users/2844b2a08be147a08ef58317d6471f1f/application_credentials", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListOutput)
+	})
+}
+
+// HandleGetApplicationCredentialSuccessfully creates an HTTP handler at `/users/{user_id}/application_credentials` on the
+// test handler mux that responds with a single application credential.
+func HandleGetApplicationCredentialSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/users/2844b2a08be147a08ef58317d6471f1f/application_credentials/f741662395b249c9b8acdebf1722c5ae", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, GetOutput)
+	})
+}
+
+// HandleCreateApplicationCredentialSuccessfully creates an HTTP handler at `/users/{user_id}/application_credentials` on the
+// test handler mux that tests application credential creation.
+func HandleCreateApplicationCredentialSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/users/2844b2a08be147a08ef58317d6471f1f/application_credentials", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, CreateResponse)
+	})
+}
+
+// HandleDeleteApplicationCredentialSuccessfully creates an HTTP handler at `/users/{user_id}/application_credentials` on the
+// test handler mux that tests application credential deletion.
+func HandleDeleteApplicationCredentialSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/users/2844b2a08be147a08ef58317d6471f1f/application_credentials/f741662395b249c9b8acdebf1722c5ae", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,88 @@
+package credentials
+
+import (
+	"crypto/x509"
+	"encoding/base32"
+	"encoding/json"
+	"encoding/pem"
+	"strings"
+)
+
+const (
+	// TypeEC2 is the type of EC2 credentials, whose blob is an EC2Blob.
+	TypeEC2 = "ec2"
+
+	// TypeTOTP is the type of TOTP secrets, whose blob is the base32-encoded
+	// secret.
+	TypeTOTP = "totp"
+
+	// TypeCert is the type of certificates, whose blob is a PEM-encoded X.509
+	// certificate.
+	TypeCert = "cert"
+)
+
+// EC2Blob is the blob of an EC2 credential, a pair of keys for the AWS APIs
+// of the cloud, e.g. the S3 API of object storage.
+type EC2Blob struct {
+	// Access is the access key. It is also the ID of the credential in
+	// the OS-EC2 API.
+	Access string `json:"access"`
+
+	// Secret is the secret key.
+	Secret string `json:"secret"`
+
+	// TrustID is the trust the credential was created with, if any.
+	TrustID string `json:"trust_id,omitempty"`
+
+	// AppCredID is the application credential the credential was created
+	// with, if any.
+	AppCredID string `json:"app_cred_id,omitempty"`
+}
+
+// ToBlob encodes an EC2Blob into the Blob of CreateOpts or UpdateOpts.
+func (b EC2Blob) ToBlob() (string, error) {
+	j, err := json.Marshal(b)
+	return string(j), err
+}
+
+// ExtractEC2Blob parses the blob of a credential of TypeEC2.
+func (c Credential) ExtractEC2Blob() (*EC2Blob, error) {
+	if c.Type != TypeEC2 {
+		return nil, ErrUnexpectedType{Expected: TypeEC2, Actual: c.Type}
+	}
+	var b EC2Blob
+	if err := json.Unmarshal([]byte(c.Blob), &b); err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+// ExtractTOTPSecret decodes the secret of a credential of TypeTOTP, the key
+// passcodes are derived from. Keystone strips the padding of the encoded
+// secret, which is accepted with or without it.
+func (c Credential) ExtractTOTPSecret() ([]byte, error) {
+	if c.Type != TypeTOTP {
+		return nil, ErrUnexpectedType{Expected: TypeTOTP, Actual: c.Type}
+	}
+	secret := strings.ToUpper(strings.TrimRight(c.Blob, "="))
+	return base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(secret)
+}
+
+// ExtractCertificate parses the first PEM-encoded certificate of a
+// credential of TypeCert.
+func (c Credential) ExtractCertificate() (*x509.Certificate, error) {
+	if c.Type != TypeCert {
+		return nil, ErrUnexpectedType{Expected: TypeCert, Actual: c.Type}
+	}
+	rest := []byte(c.Blob)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return nil, ErrNoCertificate{}
+		}
+		if block.Type == "CERTIFICATE" {
+			return x509.ParseCertificate(block.Bytes)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+/*
+Package credentials manages and retrieves Credentials in the OpenStack
+Identity Service, such as the EC2 keys and TOTP secrets of users.
+
+Example to List Credentials
+
+	listOpts := credentials.ListOpts{
+		UserID: "bb5476fd12884539b41d5a88f838d773",
+		Type:   credentials.TypeEC2,
+	}
+
+	allPages, err := credentials.List(identityClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allCredentials, err := credentials.ExtractCredentials(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, credential := range allCredentials {
+		blob, err := credential.ExtractEC2Blob()
+		if err != nil {
+			panic(err)
+		}
+		fmt.Println(blob.Access)
+	}
+
+Example to Create EC2 Credentials
+
+	blob, err := credentials.EC2Blob{
+		Access: "181920",
+		Secret: "secretKey",
+	}.ToBlob()
+	if err != nil {
+		panic(err)
+	}
+
+	createOpts := credentials.CreateOpts{
+		Blob:      blob,
+		Type:      credentials.TypeEC2,
+		UserID:    "bb5476fd12884539b41d5a88f838d773",
+		ProjectID: "731fc6f265cd486d900f16e84c5cb594",
+	}
+
+	credential, err := credentials.Create(identityClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Create a TOTP Secret
+
+	createOpts := credentials.CreateOpts{
+		Blob:   "GEZDGNBVGY3TQOJQGEZDGNBVGY",
+		Type:   credentials.TypeTOTP,
+		UserID: "bb5476fd12884539b41d5a88f838d773",
+	}
+
+	credential, err := credentials.Create(identityClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update a Credential
+
+	credentialID := "3d3367228f9c7665266604462ec60029bcd83ad89614021a80b2eb879c572510"
+
+	updateOpts := credentials.UpdateOpts{
+		ProjectID: "6e01855f345f4c59812999b5e459137d",
+	}
+
+	credential, err := credentials.Update(identityClient, credentialID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Credential
+
+	credentialID := "3d3367228f9c7665266604462ec60029bcd83ad89614021a80b2eb879c572510"
+	err := credentials.Delete(identityClient, credentialID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package credentials
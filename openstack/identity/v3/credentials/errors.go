@@ -0,0 +1,27 @@
+package credentials
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// ErrUnexpectedType is returned when parsing the blob of a credential of
+// another type.
+type ErrUnexpectedType struct {
+	gophercloud.BaseError
+	Expected string
+	Actual   string
+}
+
+func (e ErrUnexpectedType) Error() string {
+	return fmt.Sprintf("expected a credential of type %s, got %s", e.Expected, e.Actual)
+}
+
+// ErrNoCertificate is returned by ExtractCertificate when the blob of the
+// credential holds no PEM-encoded certificate.
+type ErrNoCertificate struct{ gophercloud.BaseError }
+
+func (e ErrNoCertificate) Error() string {
+	return "no PEM-encoded certificate found in the credential"
+}
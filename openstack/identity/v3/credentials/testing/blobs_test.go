@@ -0,0 +1,53 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/credentials"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestExtractEC2Blob(t *testing.T) {
+	actual, err := EC2Credential.ExtractEC2Blob()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, credentials.EC2Blob{Access: "181920", Secret: "secretKey"}, *actual)
+
+	_, err = TOTPCredential.ExtractEC2Blob()
+	if _, ok := err.(credentials.ErrUnexpectedType); !ok {
+		t.Fatalf("Expected ErrUnexpectedType, got %T: %v", err, err)
+	}
+}
+
+func TestExtractTOTPSecret(t *testing.T) {
+	actual, err := TOTPCredential.ExtractTOTPSecret()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "1234567890123456", string(actual))
+
+	padded := TOTPCredential
+	padded.Blob = "GEZDGNBVGY3TQOJQGEZDGNBVGY======"
+	actual, err = padded.ExtractTOTPSecret()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "1234567890123456", string(actual))
+
+	_, err = EC2Credential.ExtractTOTPSecret()
+	if _, ok := err.(credentials.ErrUnexpectedType); !ok {
+		t.Fatalf("Expected ErrUnexpectedType, got %T: %v", err, err)
+	}
+}
+
+func TestExtractCertificate(t *testing.T) {
+	credential := credentials.Credential{
+		Type: credentials.TypeCert,
+		Blob: CertificatePEM,
+	}
+
+	actual, err := credential.ExtractCertificate()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "gophercloud", actual.Subject.CommonName)
+
+	credential.Blob = "not a certificate"
+	_, err = credential.ExtractCertificate()
+	if _, ok := err.(credentials.ErrNoCertificate); !ok {
+		t.Fatalf("Expected ErrNoCertificate, got %T: %v", err, err)
+	}
+}
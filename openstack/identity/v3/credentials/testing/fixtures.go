@@ -0,0 +1,207 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/credentials"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// ListOutput provides a single page of Credential results.
+const ListOutput = `
+{
+    "credentials": [
+        {
+            "user_id": "bb5476fd12884539b41d5a88f838d773",
+            "links": {
+                "self": "http://identity/v3/credentials/3d3367228f9c7665266604462ec60029bcd83ad89614021a80b2eb879c572510"
+            },
+            "blob": "{\"access\":\"181920\",\"secret\":\"secretKey\"}",
+            "project_id": "731fc6f265cd486d900f16e84c5cb594",
+            "type": "ec2",
+            "id": "3d3367228f9c7665266604462ec60029bcd83ad89614021a80b2eb879c572510"
+        },
+        {
+            "user_id": "6f556708d04b4ea6bc72d7df2296b71a",
+            "links": {
+                "self": "http://identity/v3/credentials/2441494e52ab6d594a34d74586075cb299489bdd1e9389e3ab06467a4f460609"
+            },
+            "blob": "GEZDGNBVGY3TQOJQGEZDGNBVGY",
+            "project_id": null,
+            "type": "totp",
+            "id": "2441494e52ab6d594a34d74586075cb299489bdd1e9389e3ab06467a4f460609"
+        }
+    ],
+    "links": {
+        "self": "http://identity/v3/credentials",
+        "previous": null,
+        "next": null
+    }
+}
+`
+
+// GetOutput provides a Get result.
+const GetOutput = `
+{
+    "credential": {
+        "user_id": "bb5476fd12884539b41d5a88f838d773",
+        "links": {
+            "self": "http://identity/v3/credentials/3d3367228f9c7665266604462ec60029bcd83ad89614021a80b2eb879c572510"
+        },
+        "blob": "{\"access\":\"181920\",\"secret\":\"secretKey\"}",
+        "project_id": "731fc6f265cd486d900f16e84c5cb594",
+        "type": "ec2",
+        "id": "3d3367228f9c7665266604462ec60029bcd83ad89614021a80b2eb879c572510"
+    }
+}
+`
+
+// CreateRequest provides the input to a Create request.
+const CreateRequest = `
+{
+    "credential": {
+        "blob": "{\"access\":\"181920\",\"secret\":\"secretKey\"}",
+        "project_id": "731fc6f265cd486d900f16e84c5cb594",
+        "type": "ec2",
+        "user_id": "bb5476fd12884539b41d5a88f838d773"
+    }
+}
+`
+
+// UpdateRequest provides the input to an Update request.
+const UpdateRequest = `
+{
+    "credential": {
+        "project_id": "6e01855f345f4c59812999b5e459137d"
+    }
+}
+`
+
+// UpdateOutput provides an Update response.
+const UpdateOutput = `
+{
+    "credential": {
+        "user_id": "bb5476fd12884539b41d5a88f838d773",
+        "links": {
+            "self": "http://identity/v3/credentials/3d3367228f9c7665266604462ec60029bcd83ad89614021a80b2eb879c572510"
+        },
+        "blob": "{\"access\":\"181920\",\"secret\":\"secretKey\"}",
+        "project_id": "6e01855f345f4c59812999b5e459137d",
+        "type": "ec2",
+        "id": "3d3367228f9c7665266604462ec60029bcd83ad89614021a80b2eb879c572510"
+    }
+}
+`
+
+// CertificatePEM is the blob of a certificate credential, a self-signed
+// certificate for "gophercloud".
+const CertificatePEM = `-----BEGIN CERTIFICATE-----
+MIIBgzCCASmgAwIBAgIUf1yZXP7mlZSYKC5PptQO5mTnM4AwCgYIKoZIzj0EAwIw
+FjEUMBIGA1UEAwwLZ29waGVyY2xvdWQwIBcNMjYxMDE3MDM0NTUzWhgPMjEyNjA5
+MjMwMzQ1NTNaMBYxFDASBgNVBAMMC2dvcGhlcmNsb3VkMFkwEwYHKoZIzj0CAQYI
+KoZIzj0DAQcDQgAEEIu3nubtJu/3nz4hgbangVg643b8498EMOTCAReq2MzTyE+F
+oKXR4FKlOIex8BlXRdVLu2XryL202lOShBWZ5aNTMFEwHQYDVR0OBBYEFP2fHkqE
+xGjX/+OOl6C659nxXBaGMB8GA1UdIwQYMBaAFP2fHkqExGjX/+OOl6C659nxXBaG
+MA8GA1UdEwEB/wQFMAMBAf8wCgYIKoZIzj0EAwIDSAAwRQIgBoLK0NIqSHKAIH8J
+S4L90CZgXqPCmJKy1P3wyfI59U8CIQDddZSYhrDMYW7jb+bxraQQqk++J921gJAf
+5xvx4drXdQ==
+-----END CERTIFICATE-----
+`
+
+// EC2Credential is the EC2 credential in the List and Get results.
+var EC2Credential = credentials.Credential{
+	ID:        "3d3367228f9c7665266604462ec60029bcd83ad89614021a80b2eb879c572510",
+	Blob:      `{"access":"181920","secret":"secretKey"}`,
+	Type:      credentials.TypeEC2,
+	UserID:    "bb5476fd12884539b41d5a88f838d773",
+	ProjectID: "731fc6f265cd486d900f16e84c5cb594",
+	Links: map[string]interface{}{
+		"self": "http://identity/v3/credentials/3d3367228f9c7665266604462ec60029bcd83ad89614021a80b2eb879c572510",
+	},
+}
+
+// TOTPCredential is the TOTP credential in the List result.
+var TOTPCredential = credentials.Credential{
+	ID:     "2441494e52ab6d594a34d74586075cb299489bdd1e9389e3ab06467a4f460609",
+	Blob:   "GEZDGNBVGY3TQOJQGEZDGNBVGY",
+	Type:   credentials.TypeTOTP,
+	UserID: "6f556708d04b4ea6bc72d7df2296b71a",
+	Links: map[string]interface{}{
+		"self": "http://identity/v3/credentials/2441494e52ab6d594a34d74586075cb299489bdd1e9389e3ab06467a4f460609",
+	},
+}
+
+// ExpectedCredentialsSlice is the slice of credentials expected to be
+// returned from ListOutput.
+var ExpectedCredentialsSlice = []credentials.Credential{EC2Credential, TOTPCredential}
+
+// HandleListCredentialsSuccessfully creates an HTTP handler at `/credentials`
+// on the test handler mux that responds with a list of two credentials.
+func HandleListCredentialsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/credentials", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListOutput)
+	})
+}
+
+// HandleGetCredentialSuccessfully creates an HTTP handler at
+// `/credentials/3d33...` on the test handler mux that responds with a single
+// credential.
+func HandleGetCredentialSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/credentials/3d3367228f9c7665266604462ec60029bcd83ad89614021a80b2eb879c572510", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, GetOutput)
+	})
+}
+
+// HandleCreateCredentialSuccessfully creates an HTTP handler at `/credentials`
+// on the test handler mux that tests credential creation.
+func HandleCreateCredentialSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/credentials", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, GetOutput)
+	})
+}
+
+// HandleDeleteCredentialSuccessfully creates an HTTP handler at
+// `/credentials/3d33...` on the test handler mux that tests credential
+// deletion.
+func HandleDeleteCredentialSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/credentials/3d3367228f9c7665266604462ec60029bcd83ad89614021a80b2eb879c572510", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleUpdateCredentialSuccessfully creates an HTTP handler at
+// `/credentials/3d33...` on the test handler mux that tests credential
+// updates.
+func HandleUpdateCredentialSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/credentials/3d3367228f9c7665266604462ec60029bcd83ad89614021a80b2eb879c572510", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PATCH")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, UpdateOutput)
+	})
+}
@@ -0,0 +1,113 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/credentials"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestListCredentials(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListCredentialsSuccessfully(t)
+
+	count := 0
+	err := credentials.List(client.ServiceClient(), nil).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+
+		actual, err := credentials.ExtractCredentials(page)
+		th.AssertNoErr(t, err)
+
+		th.CheckDeepEquals(t, ExpectedCredentialsSlice, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, count, 1)
+}
+
+func TestListCredentialsQuery(t *testing.T) {
+	listOpts := credentials.ListOpts{
+		UserID: "bb5476fd12884539b41d5a88f838d773",
+		Type:   credentials.TypeEC2,
+	}
+
+	query, err := listOpts.ToCredentialListQuery()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "?type=ec2&user_id=bb5476fd12884539b41d5a88f838d773", query)
+}
+
+func TestGetCredential(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetCredentialSuccessfully(t)
+
+	actual, err := credentials.Get(client.ServiceClient(), "3d3367228f9c7665266604462ec60029bcd83ad89614021a80b2eb879c572510").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, EC2Credential, *actual)
+}
+
+func TestCreateCredential(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateCredentialSuccessfully(t)
+
+	blob, err := credentials.EC2Blob{
+		Access: "181920",
+		Secret: "secretKey",
+	}.ToBlob()
+	th.AssertNoErr(t, err)
+
+	createOpts := credentials.CreateOpts{
+		Blob:      blob,
+		Type:      credentials.TypeEC2,
+		UserID:    "bb5476fd12884539b41d5a88f838d773",
+		ProjectID: "731fc6f265cd486d900f16e84c5cb594",
+	}
+
+	actual, err := credentials.Create(client.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, EC2Credential, *actual)
+}
+
+func TestCreateCredentialMissingType(t *testing.T) {
+	createOpts := credentials.CreateOpts{
+		Blob:   "GEZDGNBVGY3TQOJQGEZDGNBVGY",
+		UserID: "bb5476fd12884539b41d5a88f838d773",
+	}
+
+	_, err := credentials.Create(client.ServiceClient(), createOpts).Extract()
+	if _, ok := err.(gophercloud.ErrMissingInput); !ok {
+		t.Fatalf("Expected ErrMissingInput, got %T: %v", err, err)
+	}
+}
+
+func TestDeleteCredential(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteCredentialSuccessfully(t)
+
+	res := credentials.Delete(client.ServiceClient(), "3d3367228f9c7665266604462ec60029bcd83ad89614021a80b2eb879c572510")
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestUpdateCredential(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateCredentialSuccessfully(t)
+
+	updateOpts := credentials.UpdateOpts{
+		ProjectID: "6e01855f345f4c59812999b5e459137d",
+	}
+
+	expected := EC2Credential
+	expected.ProjectID = "6e01855f345f4c59812999b5e459137d"
+
+	actual, err := credentials.Update(client.ServiceClient(), "3d3367228f9c7665266604462ec60029bcd83ad89614021a80b2eb879c572510", updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, expected, *actual)
+}
@@ -0,0 +1,59 @@
+/*
+Package ec2credentials provides information and interaction with the OS-EC2
+extension of the OpenStack Identity service, which manages the EC2 keys of
+users, as used by the S3 APIs of Swift and Ceph RGW.
+
+Example to List EC2 Credentials
+
+	userID := "2844b2a08be147a08ef58317d6471f1f"
+
+	allPages, err := ec2credentials.List(identityClient, userID).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allCredentials, err := ec2credentials.ExtractCredentials(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, credential := range allCredentials {
+		fmt.Printf("%+v\n", credential)
+	}
+
+Example to Create an EC2 Credential
+
+	userID := "2844b2a08be147a08ef58317d6471f1f"
+
+	createOpts := ec2credentials.CreateOpts{
+		TenantID: "6238dee2fec940a6bf31e49e9faf995a",
+	}
+
+	credential, err := ec2credentials.Create(identityClient, userID, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("access: %s, secret: %s\n", credential.Access, credential.Secret)
+
+Example to Get an EC2 Credential
+
+	userID := "2844b2a08be147a08ef58317d6471f1f"
+	access := "f741662395b249c9b8acdebf1722c5ae"
+
+	credential, err := ec2credentials.Get(identityClient, userID, access).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete an EC2 Credential
+
+	userID := "2844b2a08be147a08ef58317d6471f1f"
+	access := "f741662395b249c9b8acdebf1722c5ae"
+
+	err := ec2credentials.Delete(identityClient, userID, access).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package ec2credentials
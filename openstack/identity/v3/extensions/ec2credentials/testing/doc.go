@@ -0,0 +1,2 @@
+// ec2credentials unit tests
+package testing
@@ -0,0 +1,153 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/extensions/ec2credentials"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+const userID = "2844b2a08be147a08ef58317d6471f1f"
+
+// ListOutput provides a single page of EC2Credential results.
+const ListOutput = `
+{
+    "credentials": [
+        {
+            "user_id": "2844b2a08be147a08ef58317d6471f1f",
+            "links": {
+                "self": "http://identity:5000/v3/users/2844b2a08be147a08ef58317d6471f1f/credentials/OS-EC2/f741662395b249c9b8acdebf1722c5ae"
+            },
+            "tenant_id": "6238dee2fec940a6bf31e49e9faf995a",
+            "access": "f741662395b249c9b8acdebf1722c5ae",
+            "secret": "6a61eb0296034c89b49cc51dea37b0ac",
+            "trust_id": null
+        },
+        {
+            "user_id": "2844b2a08be147a08ef58317d6471f1f",
+            "links": {
+                "self": "http://identity:5000/v3/users/2844b2a08be147a08ef58317d6471f1f/credentials/OS-EC2/ad6fc85fc2df49e6b5c23d5b5bbeb7c8"
+            },
+            "tenant_id": "c9f3b3d28f2f4b3ea4aa8f8c3a1c4d0d",
+            "access": "ad6fc85fc2df49e6b5c23d5b5bbeb7c8",
+            "secret": "5c4c5d4f2e8a4f1c9b6b8e0c2a7d3f91",
+            "trust_id": "9b9b3d1a6c6f4b7e8a2a5d4e3c1b0a9f"
+        }
+    ],
+    "links": {
+        "self": "http://identity:5000/v3/users/2844b2a08be147a08ef58317d6471f1f/credentials/OS-EC2",
+        "previous": null,
+        "next": null
+    }
+}
+`
+
+// GetOutput provides a Get result.
+const GetOutput = `
+{
+    "credential": {
+        "user_id": "2844b2a08be147a08ef58317d6471f1f",
+        "links": {
+            "self": "http://identity:5000/v3/users/2844b2a08be147a08ef58317d6471f1f/credentials/OS-EC2/f741662395b249c9b8acdebf1722c5ae"
+        },
+        "tenant_id": "6238dee2fec940a6bf31e49e9faf995a",
+        "access": "f741662395b249c9b8acdebf1722c5ae",
+        "secret": "6a61eb0296034c89b49cc51dea37b0ac",
+        "trust_id": null
+    }
+}
+`
+
+// CreateRequest provides the input to a Create request.
+const CreateRequest = `
+{
+    "tenant_id": "6238dee2fec940a6bf31e49e9faf995a"
+}
+`
+
+// EC2Credential is the first EC2 credential in the List request.
+var EC2Credential = ec2credentials.Credential{
+	UserID:   "2844b2a08be147a08ef58317d6471f1f",
+	TenantID: "6238dee2fec940a6bf31e49e9faf995a",
+	Access:   "f741662395b249c9b8acdebf1722c5ae",
+	Secret:   "6a61eb0296034c89b49cc51dea37b0ac",
+	Links: map[string]interface{}{
+		"self": "http://identity:5000/v3/users/2844b2a08be147a08ef58317d6471f1f/credentials/OS-EC2/f741662395b249c9b8acdebf1722c5ae",
+	},
+}
+
+// SecondEC2Credential is the second EC2 credential in the List request,
+// created with a trust.
+var SecondEC2Credential = ec2credentials.Credential{
+	UserID:   "2844b2a08be147a08ef58317d6471f1f",
+	TenantID: "c9f3b3d28f2f4b3ea4aa8f8c3a1c4d0d",
+	Access:   "ad6fc85fc2df49e6b5c23d5b5bbeb7c8",
+	Secret:   "5c4c5d4f2e8a4f1c9b6b8e0c2a7d3f91",
+	TrustID:  "9b9b3d1a6c6f4b7e8a2a5d4e3c1b0a9f",
+	Links: map[string]interface{}{
+		"self": "http://identity:5000/v3/users/2844b2a08be147a08ef58317d6471f1f/credentials/OS-EC2/ad6fc85fc2df49e6b5c23d5b5bbeb7c8",
+	},
+}
+
+// ExpectedEC2CredentialsSlice is the slice of EC2 credentials expected to be
+// returned from ListOutput.
+var ExpectedEC2CredentialsSlice = []ec2credentials.Credential{EC2Credential, SecondEC2Credential}
+
+// HandleListEC2CredentialsSuccessfully creates an HTTP handler at
+// `/users/{user_id}/credentials/OS-EC2` on the test handler mux that
+// responds with a list of two EC2 credentials.
+func HandleListEC2CredentialsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/users/"+userID+"/credentials/OS-EC2", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListOutput)
+	})
+}
+
+// HandleGetEC2CredentialSuccessfully creates an HTTP handler at
+// `/users/{user_id}/credentials/OS-EC2/{access}` on the test handler mux
+// that responds with a single EC2 credential.
+func HandleGetEC2CredentialSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/users/"+userID+"/credentials/OS-EC2/f741662395b249c9b8acdebf1722c5ae", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, GetOutput)
+	})
+}
+
+// HandleCreateEC2CredentialSuccessfully creates an HTTP handler at
+// `/users/{user_id}/credentials/OS-EC2` on the test handler mux that tests
+// EC2 credential creation.
+func HandleCreateEC2CredentialSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/users/"+userID+"/credentials/OS-EC2", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, GetOutput)
+	})
+}
+
+// HandleDeleteEC2CredentialSuccessfully creates an HTTP handler at
+// `/users/{user_id}/credentials/OS-EC2/{access}` on the test handler mux
+// that tests EC2 credential deletion.
+func HandleDeleteEC2CredentialSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/users/"+userID+"/credentials/OS-EC2/f741662395b249c9b8acdebf1722c5ae", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,71 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/extensions/ec2credentials"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestListEC2Credentials(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListEC2CredentialsSuccessfully(t)
+
+	count := 0
+	err := ec2credentials.List(client.ServiceClient(), userID).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+
+		actual, err := ec2credentials.ExtractCredentials(page)
+		th.AssertNoErr(t, err)
+
+		th.CheckDeepEquals(t, ExpectedEC2CredentialsSlice, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, count, 1)
+}
+
+func TestGetEC2Credential(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetEC2CredentialSuccessfully(t)
+
+	actual, err := ec2credentials.Get(client.ServiceClient(), userID, "f741662395b249c9b8acdebf1722c5ae").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, EC2Credential, *actual)
+}
+
+func TestCreateEC2Credential(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateEC2CredentialSuccessfully(t)
+
+	createOpts := ec2credentials.CreateOpts{
+		TenantID: "6238dee2fec940a6bf31e49e9faf995a",
+	}
+
+	actual, err := ec2credentials.Create(client.ServiceClient(), userID, createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, EC2Credential, *actual)
+}
+
+func TestCreateEC2CredentialMissingTenantID(t *testing.T) {
+	_, err := ec2credentials.Create(client.ServiceClient(), userID, ec2credentials.CreateOpts{}).Extract()
+	if _, ok := err.(gophercloud.ErrMissingInput); !ok {
+		t.Fatalf("Expected ErrMissingInput, got %T: %v", err, err)
+	}
+}
+
+func TestDeleteEC2Credential(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteEC2CredentialSuccessfully(t)
+
+	res := ec2credentials.Delete(client.ServiceClient(), userID, "f741662395b249c9b8acdebf1722c5ae")
+	th.AssertNoErr(t, res.Err)
+}
@@ -0,0 +1,37 @@
+/*
+Package ec2tokens provides authentication with the EC2 credentials of the
+OpenStack Identity service, through its ec2tokens API. The request sent to
+Keystone is signed with AWS signature version 4, so that the secret key of the
+credential is never sent.
+
+Example to Create a Token From an EC2 Credential
+
+	authOptions := ec2tokens.AuthOptions{
+		Access: "a7f1e798b7c2417cba4a02de97dc3cdc",
+		Secret: "18f4f6761ada4e3795fa5273c30349b9",
+	}
+
+	token, err := ec2tokens.Create(identityClient, &authOptions).ExtractToken()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Authenticate a Provider Client With an EC2 Credential
+
+	authOptions := ec2tokens.AuthOptions{
+		Access:      "a7f1e798b7c2417cba4a02de97dc3cdc",
+		Secret:      "18f4f6761ada4e3795fa5273c30349b9",
+		AllowReauth: true,
+	}
+
+	provider, err := openstack.NewClient("https://keystone.example.com/v3")
+	if err != nil {
+		panic(err)
+	}
+
+	err = openstack.AuthenticateV3(provider, &authOptions, gophercloud.EndpointOpts{})
+	if err != nil {
+		panic(err)
+	}
+*/
+package ec2tokens
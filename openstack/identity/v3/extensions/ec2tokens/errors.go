@@ -0,0 +1,12 @@
+package ec2tokens
+
+import "github.com/gophercloud/gophercloud"
+
+// ErrNotExchanged is the error when AuthOptions are used to build a request
+// to the tokens API, since they must be sent to the ec2tokens API through
+// Create.
+type ErrNotExchanged struct{ gophercloud.BaseError }
+
+func (e ErrNotExchanged) Error() string {
+	return "EC2 authentication options must be used with ec2tokens.Create or openstack.AuthenticateV3"
+}
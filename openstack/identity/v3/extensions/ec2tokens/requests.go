@@ -0,0 +1,310 @@
+package ec2tokens
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/tokens"
+)
+
+const (
+	// AlgorithmV4 is the algorithm of AWS signature version 4.
+	AlgorithmV4 = "AWS4-HMAC-SHA256"
+
+	// TimestampFormatV4 is the format of the X-Amz-Date header.
+	TimestampFormatV4 = "20060102T150405Z"
+
+	// DateFormatV4 is the format of the date of the credential scope.
+	DateFormatV4 = "20060102"
+
+	// TerminatorV4 ends the credential scope.
+	TerminatorV4 = "aws4_request"
+)
+
+// emptyBodyHash is the SHA-256 hash of an empty request body.
+const emptyBodyHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+
+// AuthOptions are the options to authenticate with an EC2 credential, such as
+// one created with the ec2credentials package. They describe a request to an
+// AWS API of the cloud, which is signed with AWS signature version 4 and sent
+// to Keystone along with the access key. The Keystone token obtained is
+// scoped to the project of the credential.
+//
+// AuthOptions implement tokens.AuthMethod so that they can be passed to
+// openstack.AuthenticateV3, but must be passed to Create rather than
+// tokens.Create, since the request is sent to the ec2tokens API.
+type AuthOptions struct {
+	// Access is the access key of the EC2 credential. Required.
+	Access string
+
+	// Secret is the secret key of the EC2 credential. It signs the request,
+	// but isn't sent to Keystone. Required.
+	Secret string
+
+	// Host is the host the signed request is sent to. It is signed as the
+	// Host header, unless Headers has one.
+	Host string
+
+	// Path is the path of the signed request. Defaults to "/".
+	Path string
+
+	// Verb is the method of the signed request. Defaults to "POST".
+	Verb string
+
+	// Headers are the headers of the signed request. They are all signed,
+	// along with the X-Amz-Date header added to them.
+	Headers map[string]string
+
+	// Params are the query parameters of the signed request.
+	Params map[string]string
+
+	// BodyHash is the hex-encoded SHA-256 hash of the body of the signed
+	// request. Defaults to the hash of an empty body.
+	BodyHash *string
+
+	// Region and Service are part of the credential scope. They default to
+	// "RegionOne" and "ec2".
+	Region  string
+	Service string
+
+	// Timestamp is the time at which the request is signed. Defaults to
+	// the current time.
+	Timestamp *time.Time
+
+	// AllowReauth allows the client to authenticate again when the token
+	// expires.
+	AllowReauth bool
+}
+
+// ToTokenV3CreateMap implements tokens.AuthOptionsBuilder. It always fails,
+// since the options must be sent to the ec2tokens API through Create.
+func (opts *AuthOptions) ToTokenV3CreateMap(map[string]interface{}) (map[string]interface{}, error) {
+	return nil, ErrNotExchanged{}
+}
+
+// toCreateMap builds the request body of Create, signing the request
+// described by opts.
+func (opts *AuthOptions) toCreateMap() (map[string]interface{}, error) {
+	if opts.Access == "" {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "Access"
+		return nil, err
+	}
+	if opts.Secret == "" {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "Secret"
+		return nil, err
+	}
+
+	verb := opts.Verb
+	if verb == "" {
+		verb = "POST"
+	}
+	path := opts.Path
+	if path == "" {
+		path = "/"
+	}
+	bodyHash := emptyBodyHash
+	if opts.BodyHash != nil {
+		bodyHash = *opts.BodyHash
+	}
+	params := opts.Params
+	if params == nil {
+		params = map[string]string{}
+	}
+	t := time.Now()
+	if opts.Timestamp != nil {
+		t = *opts.Timestamp
+	}
+	t = t.UTC()
+
+	headers := make(map[string]string, len(opts.Headers)+3)
+	hasHost := false
+	for k, v := range opts.Headers {
+		headers[k] = v
+		hasHost = hasHost || strings.EqualFold(k, "Host")
+	}
+	if !hasHost && opts.Host != "" {
+		headers["Host"] = opts.Host
+	}
+	headers["X-Amz-Date"] = t.Format(TimestampFormatV4)
+
+	scope := opts.credentialScope(t)
+	signedHeaders, canonicalHeaders := canonicalHeadersV4(headers)
+	canonicalRequest := strings.Join([]string{
+		strings.ToUpper(verb),
+		path,
+		canonicalQueryStringV4(params),
+		canonicalHeaders,
+		signedHeaders,
+		bodyHash,
+	}, "\n")
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{
+		AlgorithmV4,
+		headers["X-Amz-Date"],
+		scope,
+		hex.EncodeToString(requestHash[:]),
+	}, "\n")
+	signature := hex.EncodeToString(hmacSHA256(opts.signingKey(t), stringToSign))
+
+	// Keystone reads the credential scope and the signed headers from the
+	// Authorization header.
+	headers["Authorization"] = fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		AlgorithmV4, opts.Access, scope, signedHeaders, signature)
+
+	return map[string]interface{}{
+		"credentials": map[string]interface{}{
+			"access":    opts.Access,
+			"host":      opts.Host,
+			"verb":      verb,
+			"path":      path,
+			"params":    params,
+			"headers":   headers,
+			"body_hash": bodyHash,
+			"signature": signature,
+		},
+	}, nil
+}
+
+// ToTokenV3ScopeMap implements tokens.AuthOptionsBuilder. The token is
+// always scoped to the project of the credential.
+func (opts *AuthOptions) ToTokenV3ScopeMap() (map[string]interface{}, error) {
+	return nil, nil
+}
+
+// CanReauth reports whether the client may authenticate again.
+func (opts *AuthOptions) CanReauth() bool {
+	return opts.AllowReauth
+}
+
+// CreateToken implements tokens.AuthMethod, see Create.
+func (opts *AuthOptions) CreateToken(client *gophercloud.ServiceClient) tokens.CreateResult {
+	return Create(client, opts)
+}
+
+// TokenCacheRequest implements tokens.AuthMethod. It doesn't depend on the
+// signed request, which changes with time. The token is scoped to the project
+// of the credential, so the access key identifies both the user and the
+// scope.
+func (opts *AuthOptions) TokenCacheRequest(map[string]interface{}) map[string]interface{} {
+	secret := sha256.Sum256([]byte(opts.Secret))
+	return map[string]interface{}{
+		"auth": map[string]interface{}{
+			"identity": map[string]interface{}{
+				"ec2": map[string]interface{}{
+					"access":        opts.Access,
+					"secret_sha256": hex.EncodeToString(secret[:]),
+				},
+			},
+		},
+	}
+}
+
+// WithoutReauth implements tokens.AuthMethod.
+func (opts *AuthOptions) WithoutReauth() tokens.AuthOptionsBuilder {
+	o := *opts
+	o.AllowReauth = false
+	return &o
+}
+
+func (opts *AuthOptions) credentialScope(t time.Time) string {
+	region := opts.Region
+	if region == "" {
+		region = "RegionOne"
+	}
+	service := opts.Service
+	if service == "" {
+		service = "ec2"
+	}
+	return strings.Join([]string{t.Format(DateFormatV4), region, service, TerminatorV4}, "/")
+}
+
+// signingKey derives the key of the credential scope from the secret key.
+func (opts *AuthOptions) signingKey(t time.Time) []byte {
+	key := []byte("AWS4" + opts.Secret)
+	for _, part := range strings.Split(opts.credentialScope(t), "/") {
+		key = hmacSHA256(key, part)
+	}
+	return key
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+// uriEncode percent-encodes everything but the unreserved characters of
+// RFC 3986.
+func uriEncode(s string) string {
+	return strings.Replace(url.QueryEscape(s), "+", "%20", -1)
+}
+
+func canonicalQueryStringV4(params map[string]string) string {
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, len(keys))
+	for i, k := range keys {
+		pairs[i] = uriEncode(k) + "=" + uriEncode(params[k])
+	}
+	return strings.Join(pairs, "&")
+}
+
+// canonicalHeadersV4 returns the signed headers and the canonical headers of
+// a request, which sign all of its headers.
+func canonicalHeadersV4(headers map[string]string) (string, string) {
+	lower := make(map[string]string, len(headers))
+	names := make([]string, 0, len(headers))
+	for k, v := range headers {
+		name := strings.ToLower(strings.TrimSpace(k))
+		lower[name] = strings.TrimSpace(v)
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonical strings.Builder
+	for _, name := range names {
+		canonical.WriteString(name + ":" + lower[name] + "\n")
+	}
+	return strings.Join(names, ";"), canonical.String()
+}
+
+// Create authenticates with an EC2 credential and returns the resulting
+// Keystone token.
+//
+// The Keystone token of c, if any, isn't sent, and a 401 response doesn't
+// cause c to reauthenticate.
+func Create(c *gophercloud.ServiceClient, opts *AuthOptions) (r tokens.CreateResult) {
+	b, err := opts.toCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	if c.ProviderClient.ReauthFunc != nil {
+		provider := *c.ProviderClient
+		provider.ReauthFunc = nil
+		client := *c
+		client.ProviderClient = &provider
+		c = &client
+	}
+
+	resp, err := c.Post(ec2tokensURL(c), b, &r.Body, &gophercloud.RequestOpts{
+		MoreHeaders: map[string]string{"X-Auth-Token": ""},
+		OkCodes:     []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
@@ -0,0 +1,2 @@
+// ec2tokens unit tests
+package testing
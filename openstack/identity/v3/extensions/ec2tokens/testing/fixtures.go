@@ -0,0 +1,108 @@
+package testing
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+// CreateRequest provides the input to a Create request, signed with the
+// secret key "18f4f6761ada4e3795fa5273c30349b9".
+const CreateRequest = `
+{
+    "credentials": {
+        "access": "a7f1e798b7c2417cba4a02de97dc3cdc",
+        "host": "localhost:8080",
+        "verb": "GET",
+        "path": "/",
+        "params": {
+            "Action": "Test",
+            "Foo Bar": "a~b/c"
+        },
+        "headers": {
+            "Authorization": "AWS4-HMAC-SHA256 Credential=a7f1e798b7c2417cba4a02de97dc3cdc/20200102/RegionOne/ec2/aws4_request, SignedHeaders=content-type;host;x-amz-date, Signature=5d0dcb3d8d5f47aa827cecf17ac8e6134caec0f84663556c5f8d102e1b2a4e17",
+            "Content-Type": "application/x-www-form-urlencoded; charset=utf-8",
+            "Host": "localhost:8080",
+            "X-Amz-Date": "20200102T030405Z"
+        },
+        "body_hash": "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+        "signature": "5d0dcb3d8d5f47aa827cecf17ac8e6134caec0f84663556c5f8d102e1b2a4e17"
+    }
+}
+`
+
+// TokenOutput is a token scoped to the project of the EC2 credential.
+const TokenOutput = `
+{
+    "token": {
+        "methods": ["ec2credential"],
+        "expires_at": "2020-01-02T04:04:05.000000Z",
+        "project": {
+            "domain": {
+                "id": "default",
+                "name": "Default"
+            },
+            "id": "6238dee2fec940a6bf31e49e9faf995a",
+            "name": "s3"
+        },
+        "user": {
+            "domain": {
+                "id": "default",
+                "name": "Default"
+            },
+            "id": "2844b2a08be147a08ef58317d6471f1f",
+            "name": "swift"
+        }
+    }
+}
+`
+
+// HandleCreateTokenSuccessfully creates an HTTP handler at `/ec2tokens` on
+// the test handler mux that checks the signed request and responds with a
+// token.
+func HandleCreateTokenSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/ec2tokens", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Set("X-Subject-Token", "e6b1fa5b8cbb4cd5a3c1b9f7f8b3d2a1")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, TokenOutput)
+	})
+}
+
+// HandleCreateTokenWithCredentials creates an HTTP handler at `/ec2tokens` on
+// the test handler mux that stores the credentials of the request in
+// credentials and responds with a token.
+func HandleCreateTokenWithCredentials(t *testing.T, credentials *map[string]interface{}) {
+	th.Mux.HandleFunc("/ec2tokens", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+
+		var body struct {
+			Credentials map[string]interface{} `json:"credentials"`
+		}
+		th.AssertNoErr(t, json.NewDecoder(r.Body).Decode(&body))
+		*credentials = body.Credentials
+
+		w.Header().Set("X-Subject-Token", "e6b1fa5b8cbb4cd5a3c1b9f7f8b3d2a1")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, TokenOutput)
+	})
+}
+
+// HandleCreateTokenUnauthorized creates an HTTP handler at `/ec2tokens` on
+// the test handler mux that rejects the credentials.
+func HandleCreateTokenUnauthorized(t *testing.T) {
+	th.Mux.HandleFunc("/ec2tokens", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+}
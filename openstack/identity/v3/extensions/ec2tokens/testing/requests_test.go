@@ -0,0 +1,124 @@
+package testing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/extensions/ec2tokens"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/tokens"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateTokenSuccessfully(t)
+
+	timestamp := time.Date(2020, 1, 2, 4, 4, 5, 0, time.FixedZone("CET", 3600))
+	authOptions := ec2tokens.AuthOptions{
+		Access: "a7f1e798b7c2417cba4a02de97dc3cdc",
+		Secret: "18f4f6761ada4e3795fa5273c30349b9",
+		Host:   "localhost:8080",
+		Verb:   "GET",
+		Headers: map[string]string{
+			"Content-Type": "application/x-www-form-urlencoded; charset=utf-8",
+		},
+		Params: map[string]string{
+			"Action":  "Test",
+			"Foo Bar": "a~b/c",
+		},
+		Timestamp: &timestamp,
+	}
+
+	result := ec2tokens.Create(client.ServiceClient(), &authOptions)
+	token, err := result.ExtractToken()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "e6b1fa5b8cbb4cd5a3c1b9f7f8b3d2a1", token.ID)
+	th.CheckEquals(t, time.Date(2020, 1, 2, 4, 4, 5, 0, time.UTC), token.ExpiresAt)
+
+	project, err := result.ExtractProject()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "6238dee2fec940a6bf31e49e9faf995a", project.ID)
+}
+
+func TestCreateDefaults(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	var credentials map[string]interface{}
+	HandleCreateTokenWithCredentials(t, &credentials)
+
+	authOptions := ec2tokens.AuthOptions{
+		Access: "a7f1e798b7c2417cba4a02de97dc3cdc",
+		Secret: "18f4f6761ada4e3795fa5273c30349b9",
+	}
+
+	before := time.Now().UTC().Add(-time.Second)
+	err := ec2tokens.Create(client.ServiceClient(), &authOptions).Err
+	th.AssertNoErr(t, err)
+
+	th.CheckEquals(t, "POST", credentials["verb"])
+	th.CheckEquals(t, "/", credentials["path"])
+	th.CheckDeepEquals(t, map[string]interface{}{}, credentials["params"])
+	th.CheckEquals(t, "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", credentials["body_hash"])
+
+	headers := credentials["headers"].(map[string]interface{})
+	date, err := time.Parse(ec2tokens.TimestampFormatV4, headers["X-Amz-Date"].(string))
+	th.AssertNoErr(t, err)
+	if date.Before(before) {
+		t.Errorf("Expected the request to be signed now, got %s", date)
+	}
+	_, hasHost := headers["Host"]
+	th.CheckEquals(t, false, hasHost)
+	th.CheckEquals(t, "AWS4-HMAC-SHA256 Credential=a7f1e798b7c2417cba4a02de97dc3cdc/"+date.Format(ec2tokens.DateFormatV4)+
+		"/RegionOne/ec2/aws4_request, SignedHeaders=x-amz-date, Signature="+credentials["signature"].(string), headers["Authorization"])
+}
+
+func TestCreateUnauthorizedWithReauth(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateTokenUnauthorized(t)
+
+	reauths := 0
+	c := client.ServiceClient()
+	c.ReauthFunc = func() error {
+		reauths++
+		return nil
+	}
+
+	authOptions := ec2tokens.AuthOptions{
+		Access: "a7f1e798b7c2417cba4a02de97dc3cdc",
+		Secret: "18f4f6761ada4e3795fa5273c30349b9",
+	}
+
+	err := ec2tokens.Create(c, &authOptions).Err
+	if _, ok := err.(gophercloud.ErrDefault401); !ok {
+		t.Fatalf("Expected ErrDefault401, got %T: %v", err, err)
+	}
+	th.CheckEquals(t, 0, reauths)
+}
+
+func TestTokensCreate(t *testing.T) {
+	authOptions := ec2tokens.AuthOptions{
+		Access: "a7f1e798b7c2417cba4a02de97dc3cdc",
+		Secret: "18f4f6761ada4e3795fa5273c30349b9",
+	}
+
+	err := tokens.Create(client.ServiceClient(), &authOptions).Err
+	if _, ok := err.(ec2tokens.ErrNotExchanged); !ok {
+		t.Fatalf("Expected ErrNotExchanged, got %T: %v", err, err)
+	}
+}
+
+func TestCreateMissingSecret(t *testing.T) {
+	authOptions := ec2tokens.AuthOptions{
+		Access: "a7f1e798b7c2417cba4a02de97dc3cdc",
+	}
+
+	err := ec2tokens.Create(client.ServiceClient(), &authOptions).Err
+	if err, ok := err.(gophercloud.ErrMissingInput); !ok || err.Argument != "Secret" {
+		t.Fatalf("Expected ErrMissingInput for Secret, got %T: %v", err, err)
+	}
+}
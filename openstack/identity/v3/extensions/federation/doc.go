@@ -0,0 +1,100 @@
+/*
+Package federation manages the identity providers, mappings, protocols and
+service providers of the OS-FEDERATION extension of the OpenStack Identity
+service, and evaluates mappings locally.
+
+Example to Register an Identity Provider
+
+	enabled := true
+	createOpts := federation.CreateIdentityProviderOpts{
+		DomainID:  "c7dfdd4e7a394c1d87c3c9a0c5a7d8b2",
+		Enabled:   &enabled,
+		RemoteIDs: []string{"https://accounts.example.com"},
+	}
+
+	idp, err := federation.CreateIdentityProvider(identityClient, "sso", createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Create a Mapping
+
+	createOpts := federation.MappingOpts{
+		Rules: []federation.MappingRule{
+			{
+				Local: []federation.RuleLocal{
+					{
+						User: &federation.RuleUser{
+							Name:  "{0}",
+							Email: "{1}",
+						},
+					},
+					{
+						Groups: "{2}",
+						Domain: &federation.RuleDomain{ID: "c7dfdd4e7a394c1d87c3c9a0c5a7d8b2"},
+					},
+				},
+				Remote: []federation.RuleRemote{
+					{Type: "OIDC-preferred_username"},
+					{Type: "OIDC-email"},
+					{
+						Type:      "OIDC-groups",
+						Whitelist: []string{"developers", "operators"},
+					},
+					{
+						Type:     "OIDC-email",
+						AnyOneOf: []string{".*@example\\.com$"},
+						Regex:    true,
+					},
+				},
+			},
+		},
+	}
+
+	mapping, err := federation.CreateMapping(identityClient, "sso-mapping", createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Add a Protocol to an Identity Provider
+
+	protocolOpts := federation.ProtocolOpts{
+		MappingID: "sso-mapping",
+	}
+
+	protocol, err := federation.CreateProtocol(identityClient, "sso", "openid", protocolOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to List Service Providers
+
+	allPages, err := federation.ListServiceProviders(identityClient, nil).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allServiceProviders, err := federation.ExtractServiceProviders(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Predict how a Mapping Maps an Assertion
+
+	mapping, err := federation.GetMapping(identityClient, "sso-mapping").Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	props, err := mapping.Evaluate(federation.Assertion{
+		"OIDC-preferred_username": {"jdoe"},
+		"OIDC-email":              {"jdoe@example.com"},
+		"OIDC-groups":             {"developers", "marketing"},
+	})
+	if _, ok := err.(federation.ErrNoMatchingRule); ok {
+		// The user would be rejected.
+	}
+
+	fmt.Println(props.User.Name, props.Groups)
+*/
+package federation
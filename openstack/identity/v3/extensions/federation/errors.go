@@ -0,0 +1,29 @@
+package federation
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// ErrNoMatchingRule is returned by EvaluateRules when no rule applies to the
+// assertion, in which case Keystone rejects the authentication.
+type ErrNoMatchingRule struct {
+	gophercloud.BaseError
+}
+
+func (e ErrNoMatchingRule) Error() string {
+	return "no mapping rule applies to the assertion"
+}
+
+// ErrDirectMappingIndex is returned by EvaluateRules when a local property
+// refers to a remote requirement which isn't a direct mapping.
+type ErrDirectMappingIndex struct {
+	gophercloud.BaseError
+	Index int
+	Count int
+}
+
+func (e ErrDirectMappingIndex) Error() string {
+	return fmt.Sprintf("local property refers to direct mapping {%d}, but the rule only has %d", e.Index, e.Count)
+}
@@ -0,0 +1,337 @@
+package federation
+
+import (
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Assertion holds the attributes of an assertion of an identity provider, as
+// passed to Keystone, e.g. "OIDC-email" or "REMOTE_USER". The values which
+// Keystone receives separated by semicolons are separate elements.
+type Assertion map[string][]string
+
+// MappedProperties are the properties the rules of a mapping map an
+// assertion to.
+type MappedProperties struct {
+	// User is the mapped user. It is nil if no rule maps a user. Its Type
+	// defaults to UserTypeEphemeral.
+	User *RuleUser
+
+	// GroupIDs are the IDs of the groups the user belongs to.
+	GroupIDs []string
+
+	// Groups are the groups the user belongs to by name, along with their
+	// domain.
+	Groups []RuleGroup
+
+	// Projects are the projects on which the user is granted roles.
+	Projects []RuleProject
+}
+
+// Evaluate is a shortcut for EvaluateRules with the rules of the mapping.
+func (m Mapping) Evaluate(assertion Assertion) (*MappedProperties, error) {
+	return EvaluateRules(m.Rules, assertion)
+}
+
+/*
+EvaluateRules predicts the properties the rules of a mapping map an assertion
+to, without a request to Keystone. The rules are evaluated the way Keystone
+does:
+
+A rule applies if the assertion satisfies all of its remote requirements. A
+requirement on an attribute the assertion doesn't have is never satisfied.
+
+The requirements without AnyOneOf or NotAnyOf are direct mappings: the
+values of their attribute, filtered by Whitelist or Blacklist, replace "{0}"
+in the local properties for the first of them, "{1}" for the second, and so
+on. Several values are separated by semicolons, except in Groups and GroupIDs
+where they are separate groups.
+
+The user is mapped by the first rule which applies and maps a user, while the
+groups and projects of all the rules which apply are merged.
+
+EvaluateRules returns an ErrNoMatchingRule if no rule applies.
+*/
+func EvaluateRules(rules []MappingRule, assertion Assertion) (*MappedProperties, error) {
+	var (
+		props     MappedProperties
+		matched   bool
+		groupIDs  = make(map[string]bool)
+		groups    = make(map[groupKey]bool)
+		projectOf = make(map[string]int)
+	)
+
+	for _, rule := range rules {
+		directMaps, ok, err := evaluateRemote(rule.Remote, assertion)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		matched = true
+
+		for _, local := range rule.Local {
+			local, err := local.substitute(directMaps)
+			if err != nil {
+				return nil, err
+			}
+
+			if local.User != nil && props.User == nil {
+				props.User = local.User
+				if props.User.Type == "" {
+					props.User.Type = UserTypeEphemeral
+				}
+			}
+
+			if g := local.Group; g != nil {
+				if g.ID != "" {
+					if !groupIDs[g.ID] {
+						groupIDs[g.ID] = true
+						props.GroupIDs = append(props.GroupIDs, g.ID)
+					}
+				} else {
+					props.addGroup(groups, RuleGroup{Name: g.Name, Domain: g.Domain})
+				}
+			}
+
+			for _, name := range splitGroups(local.Groups) {
+				props.addGroup(groups, RuleGroup{Name: name, Domain: local.Domain})
+			}
+
+			for _, id := range splitGroups(local.GroupIDs) {
+				if !groupIDs[id] {
+					groupIDs[id] = true
+					props.GroupIDs = append(props.GroupIDs, id)
+				}
+			}
+
+			for _, project := range local.Projects {
+				if i, ok := projectOf[project.Name]; ok {
+					props.Projects[i].Roles = mergeRoles(props.Projects[i].Roles, project.Roles)
+					continue
+				}
+				projectOf[project.Name] = len(props.Projects)
+				props.Projects = append(props.Projects, project)
+			}
+		}
+	}
+
+	if !matched {
+		return nil, ErrNoMatchingRule{}
+	}
+	return &props, nil
+}
+
+// groupKey identifies a group by name.
+type groupKey struct {
+	name, domainID, domainName string
+}
+
+// addGroup adds a group by name, unless it was already added.
+func (props *MappedProperties) addGroup(seen map[groupKey]bool, g RuleGroup) {
+	key := groupKey{name: g.Name}
+	if g.Domain != nil {
+		key.domainID, key.domainName = g.Domain.ID, g.Domain.Name
+	}
+	if g.Name == "" || seen[key] {
+		return
+	}
+	seen[key] = true
+	props.Groups = append(props.Groups, g)
+}
+
+// mergeRoles adds to roles those of others it doesn't have yet.
+func mergeRoles(roles, others []RuleRole) []RuleRole {
+	for _, other := range others {
+		found := false
+		for _, role := range roles {
+			found = found || role == other
+		}
+		if !found {
+			roles = append(roles, other)
+		}
+	}
+	return roles
+}
+
+// evaluateRemote checks the requirements of a rule, and returns the values of
+// its direct mappings if they are satisfied.
+func evaluateRemote(requirements []RuleRemote, assertion Assertion) ([][]string, bool, error) {
+	var directMaps [][]string
+	for _, requirement := range requirements {
+		values := assertion[requirement.Type]
+		if len(values) == 0 {
+			return nil, false, nil
+		}
+
+		switch {
+		case requirement.AnyOneOf != nil:
+			match, err := anyMatch(requirement.AnyOneOf, values, requirement.Regex)
+			if err != nil || !match {
+				return nil, false, err
+			}
+		case requirement.NotAnyOf != nil:
+			match, err := anyMatch(requirement.NotAnyOf, values, requirement.Regex)
+			if err != nil || match {
+				return nil, false, err
+			}
+		default:
+			if requirement.Blacklist != nil {
+				values = filter(values, requirement.Blacklist, false)
+			} else if requirement.Whitelist != nil {
+				values = filter(values, requirement.Whitelist, true)
+			}
+			directMaps = append(directMaps, values)
+		}
+	}
+	return directMaps, true, nil
+}
+
+// anyMatch reports whether one of values is one of candidates, or matches
+// one of them as a regular expression.
+func anyMatch(candidates, values []string, regex bool) (bool, error) {
+	for _, candidate := range candidates {
+		var re *regexp.Regexp
+		if regex {
+			var err error
+			if re, err = regexp.Compile(candidate); err != nil {
+				return false, err
+			}
+		}
+		for _, v := range values {
+			if (re != nil && re.MatchString(v)) || (re == nil && v == candidate) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// filter returns the values which are in list if keep is true, or which
+// aren't otherwise.
+func filter(values, list []string, keep bool) []string {
+	in := make(map[string]bool, len(list))
+	for _, v := range list {
+		in[v] = true
+	}
+	filtered := []string{}
+	for _, v := range values {
+		if in[v] == keep {
+			filtered = append(filtered, v)
+		}
+	}
+	return filtered
+}
+
+// splitGroups parses the value of Groups or GroupIDs, either a JSON list or
+// semicolon-separated values.
+func splitGroups(s string) []string {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil
+	}
+
+	var list []string
+	if strings.HasPrefix(s, "[") && json.Unmarshal([]byte(s), &list) == nil {
+		return list
+	}
+
+	var values []string
+	for _, v := range strings.Split(s, ";") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// substitute returns a copy of the local property in which the references to
+// direct mappings are replaced by their values.
+func (local RuleLocal) substitute(directMaps [][]string) (RuleLocal, error) {
+	var err error
+	format := func(s string) string {
+		if err != nil {
+			return s
+		}
+		var formatted string
+		formatted, err = formatDirectMaps(s, directMaps)
+		return formatted
+	}
+	domain := func(d *RuleDomain) *RuleDomain {
+		if d == nil {
+			return nil
+		}
+		return &RuleDomain{ID: format(d.ID), Name: format(d.Name)}
+	}
+
+	if u := local.User; u != nil {
+		local.User = &RuleUser{
+			ID:     format(u.ID),
+			Name:   format(u.Name),
+			Email:  format(u.Email),
+			Type:   UserType(format(string(u.Type))),
+			Domain: domain(u.Domain),
+		}
+	}
+	if g := local.Group; g != nil {
+		local.Group = &RuleGroup{
+			ID:     format(g.ID),
+			Name:   format(g.Name),
+			Domain: domain(g.Domain),
+		}
+	}
+	local.Groups = format(local.Groups)
+	local.GroupIDs = format(local.GroupIDs)
+	local.Domain = domain(local.Domain)
+
+	if local.Projects != nil {
+		projects := make([]RuleProject, len(local.Projects))
+		for i, p := range local.Projects {
+			projects[i].Name = format(p.Name)
+			for _, role := range p.Roles {
+				projects[i].Roles = append(projects[i].Roles, RuleRole{Name: format(role.Name)})
+			}
+		}
+		local.Projects = projects
+	}
+
+	return local, err
+}
+
+// formatDirectMaps replaces "{N}" in s by the values of the Nth direct
+// mapping, separated by semicolons. "{{" and "}}" stand for "{" and "}".
+func formatDirectMaps(s string, directMaps [][]string) (string, error) {
+	if !strings.ContainsAny(s, "{}") {
+		return s, nil
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		switch {
+		case strings.HasPrefix(s[i:], "{{"), strings.HasPrefix(s[i:], "}}"):
+			b.WriteByte(s[i])
+			i++
+		case s[i] == '{':
+			end := strings.IndexByte(s[i:], '}')
+			if end < 0 {
+				b.WriteString(s[i:])
+				return b.String(), nil
+			}
+			index, err := strconv.Atoi(s[i+1 : i+end])
+			if err != nil {
+				b.WriteString(s[i : i+end+1])
+			} else if index < 0 || index >= len(directMaps) {
+				return "", ErrDirectMappingIndex{Index: index, Count: len(directMaps)}
+			} else {
+				b.WriteString(strings.Join(directMaps[index], ";"))
+			}
+			i += end
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,443 @@
+package federation
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ListIdentityProvidersOptsBuilder allows extensions to add additional
+// parameters to the ListIdentityProviders request.
+type ListIdentityProvidersOptsBuilder interface {
+	ToIdentityProviderListQuery() (string, error)
+}
+
+// ListIdentityProvidersOpts provides options to filter the
+// ListIdentityProviders results.
+type ListIdentityProvidersOpts struct {
+	// ID filters the response by an identity provider ID.
+	ID string `q:"id"`
+
+	// Enabled filters the response by enabled identity providers.
+	Enabled *bool `q:"enabled"`
+}
+
+// ToIdentityProviderListQuery formats a ListIdentityProvidersOpts into a
+// query string.
+func (opts ListIdentityProvidersOpts) ToIdentityProviderListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// ListIdentityProviders enumerates the identity providers.
+func ListIdentityProviders(client *gophercloud.ServiceClient, opts ListIdentityProvidersOptsBuilder) pagination.Pager {
+	url := identityProvidersRootURL(client)
+	if opts != nil {
+		query, err := opts.ToIdentityProviderListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return IdentityProviderPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// CreateIdentityProviderOptsBuilder allows extensions to add additional
+// parameters to the CreateIdentityProvider request.
+type CreateIdentityProviderOptsBuilder interface {
+	ToIdentityProviderCreateMap() (map[string]interface{}, error)
+}
+
+// CreateIdentityProviderOpts provides options used to register an identity
+// provider.
+type CreateIdentityProviderOpts struct {
+	// DomainID is the ID of the domain the users of the identity provider
+	// are created in. A domain is created if it isn't set.
+	DomainID string `json:"domain_id,omitempty"`
+
+	// Description is a description of the identity provider.
+	Description string `json:"description,omitempty"`
+
+	// Enabled tells whether users of the identity provider can
+	// authenticate. Identity providers are disabled by default.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// RemoteIDs are the IDs the identity provider is known as in the
+	// assertions.
+	RemoteIDs []string `json:"remote_ids,omitempty"`
+
+	// AuthorizationTTL is the number of minutes the group memberships
+	// obtained through the identity provider are kept.
+	AuthorizationTTL *int `json:"authorization_ttl,omitempty"`
+}
+
+// ToIdentityProviderCreateMap formats a CreateIdentityProviderOpts into a
+// create request.
+func (opts CreateIdentityProviderOpts) ToIdentityProviderCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "identity_provider")
+}
+
+// CreateIdentityProvider registers an identity provider with the given ID.
+func CreateIdentityProvider(client *gophercloud.ServiceClient, idpID string, opts CreateIdentityProviderOptsBuilder) (r CreateIdentityProviderResult) {
+	b, err := opts.ToIdentityProviderCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Put(identityProviderURL(client, idpID), &b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// GetIdentityProvider retrieves details on a single identity provider, by ID.
+func GetIdentityProvider(client *gophercloud.ServiceClient, idpID string) (r GetIdentityProviderResult) {
+	resp, err := client.Get(identityProviderURL(client, idpID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateIdentityProviderOptsBuilder allows extensions to add additional
+// parameters to the UpdateIdentityProvider request.
+type UpdateIdentityProviderOptsBuilder interface {
+	ToIdentityProviderUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateIdentityProviderOpts provides options for updating an identity
+// provider.
+type UpdateIdentityProviderOpts struct {
+	// Description is a description of the identity provider.
+	Description *string `json:"description,omitempty"`
+
+	// Enabled tells whether users of the identity provider can
+	// authenticate.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// RemoteIDs replaces the IDs the identity provider is known as in the
+	// assertions.
+	RemoteIDs []string `json:"remote_ids,omitempty"`
+
+	// AuthorizationTTL is the number of minutes the group memberships
+	// obtained through the identity provider are kept.
+	AuthorizationTTL *int `json:"authorization_ttl,omitempty"`
+}
+
+// ToIdentityProviderUpdateMap formats an UpdateIdentityProviderOpts into an
+// update request.
+func (opts UpdateIdentityProviderOpts) ToIdentityProviderUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "identity_provider")
+}
+
+// UpdateIdentityProvider updates an existing identity provider.
+func UpdateIdentityProvider(client *gophercloud.ServiceClient, idpID string, opts UpdateIdentityProviderOptsBuilder) (r UpdateIdentityProviderResult) {
+	b, err := opts.ToIdentityProviderUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Patch(identityProviderURL(client, idpID), &b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// DeleteIdentityProvider deletes an identity provider, along with its
+// protocols and the users created through it.
+func DeleteIdentityProvider(client *gophercloud.ServiceClient, idpID string) (r DeleteIdentityProviderResult) {
+	resp, err := client.Delete(identityProviderURL(client, idpID), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ListProtocols enumerates the protocols of an identity provider.
+func ListProtocols(client *gophercloud.ServiceClient, idpID string) pagination.Pager {
+	return pagination.NewPager(client, protocolsRootURL(client, idpID), func(r pagination.PageResult) pagination.Page {
+		return ProtocolPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// ProtocolOptsBuilder allows extensions to add additional parameters to the
+// CreateProtocol and UpdateProtocol requests.
+type ProtocolOptsBuilder interface {
+	ToProtocolMap() (map[string]interface{}, error)
+}
+
+// ProtocolOpts provides options used to add a protocol to an identity
+// provider, or to update it.
+type ProtocolOpts struct {
+	// MappingID is the ID of the mapping applied to the assertions.
+	MappingID string `json:"mapping_id" required:"true"`
+
+	// RemoteIDAttribute is the attribute of the assertion holding the
+	// remote ID of the identity provider. It defaults to the one configured
+	// for the protocol in the cloud.
+	RemoteIDAttribute string `json:"remote_id_attribute,omitempty"`
+}
+
+// ToProtocolMap formats a ProtocolOpts into a request body.
+func (opts ProtocolOpts) ToProtocolMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "protocol")
+}
+
+// CreateProtocol adds a protocol to an identity provider.
+func CreateProtocol(client *gophercloud.ServiceClient, idpID, protocolID string, opts ProtocolOptsBuilder) (r CreateProtocolResult) {
+	b, err := opts.ToProtocolMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Put(protocolURL(client, idpID, protocolID), &b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// GetProtocol retrieves details on a protocol of an identity provider.
+func GetProtocol(client *gophercloud.ServiceClient, idpID, protocolID string) (r GetProtocolResult) {
+	resp, err := client.Get(protocolURL(client, idpID, protocolID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateProtocol updates a protocol of an identity provider.
+func UpdateProtocol(client *gophercloud.ServiceClient, idpID, protocolID string, opts ProtocolOptsBuilder) (r UpdateProtocolResult) {
+	b, err := opts.ToProtocolMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Patch(protocolURL(client, idpID, protocolID), &b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// DeleteProtocol removes a protocol from an identity provider.
+func DeleteProtocol(client *gophercloud.ServiceClient, idpID, protocolID string) (r DeleteProtocolResult) {
+	resp, err := client.Delete(protocolURL(client, idpID, protocolID), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ListMappings enumerates the mappings.
+func ListMappings(client *gophercloud.ServiceClient) pagination.Pager {
+	return pagination.NewPager(client, mappingsRootURL(client), func(r pagination.PageResult) pagination.Page {
+		return MappingPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// MappingOptsBuilder allows extensions to add additional parameters to the
+// CreateMapping and UpdateMapping requests.
+type MappingOptsBuilder interface {
+	ToMappingMap() (map[string]interface{}, error)
+}
+
+// MappingOpts provides options used to create a mapping, or to replace its
+// rules.
+type MappingOpts struct {
+	// Rules are the rules of the mapping.
+	Rules []MappingRule `json:"rules" required:"true"`
+
+	// SchemaVersion is the version of the schema of the rules. It defaults
+	// to the latest version supported by the cloud.
+	SchemaVersion string `json:"schema_version,omitempty"`
+}
+
+// ToMappingMap formats a MappingOpts into a request body.
+func (opts MappingOpts) ToMappingMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "mapping")
+}
+
+// CreateMapping creates a mapping with the given ID.
+func CreateMapping(client *gophercloud.ServiceClient, mappingID string, opts MappingOptsBuilder) (r CreateMappingResult) {
+	b, err := opts.ToMappingMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Put(mappingURL(client, mappingID), &b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// GetMapping retrieves details on a single mapping, by ID.
+func GetMapping(client *gophercloud.ServiceClient, mappingID string) (r GetMappingResult) {
+	resp, err := client.Get(mappingURL(client, mappingID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateMapping replaces the rules of a mapping.
+func UpdateMapping(client *gophercloud.ServiceClient, mappingID string, opts MappingOptsBuilder) (r UpdateMappingResult) {
+	b, err := opts.ToMappingMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Patch(mappingURL(client, mappingID), &b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// DeleteMapping deletes a mapping.
+func DeleteMapping(client *gophercloud.ServiceClient, mappingID string) (r DeleteMappingResult) {
+	resp, err := client.Delete(mappingURL(client, mappingID), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ListServiceProvidersOptsBuilder allows extensions to add additional
+// parameters to the ListServiceProviders request.
+type ListServiceProvidersOptsBuilder interface {
+	ToServiceProviderListQuery() (string, error)
+}
+
+// ListServiceProvidersOpts provides options to filter the
+// ListServiceProviders results.
+type ListServiceProvidersOpts struct {
+	// ID filters the response by a service provider ID.
+	ID string `q:"id"`
+
+	// Enabled filters the response by enabled service providers.
+	Enabled *bool `q:"enabled"`
+}
+
+// ToServiceProviderListQuery formats a ListServiceProvidersOpts into a query
+// string.
+func (opts ListServiceProvidersOpts) ToServiceProviderListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// ListServiceProviders enumerates the service providers.
+func ListServiceProviders(client *gophercloud.ServiceClient, opts ListServiceProvidersOptsBuilder) pagination.Pager {
+	url := serviceProvidersRootURL(client)
+	if opts != nil {
+		query, err := opts.ToServiceProviderListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return ServiceProviderPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// CreateServiceProviderOptsBuilder allows extensions to add additional
+// parameters to the CreateServiceProvider request.
+type CreateServiceProviderOptsBuilder interface {
+	ToServiceProviderCreateMap() (map[string]interface{}, error)
+}
+
+// CreateServiceProviderOpts provides options used to register a service
+// provider.
+type CreateServiceProviderOpts struct {
+	// AuthURL is the URL to authenticate to the service provider with an
+	// assertion of this cloud.
+	AuthURL string `json:"auth_url" required:"true"`
+
+	// SPURL is the URL the assertions are posted to.
+	SPURL string `json:"sp_url" required:"true"`
+
+	// Description is a description of the service provider.
+	Description string `json:"description,omitempty"`
+
+	// Enabled tells whether the service provider is listed in the service
+	// catalog of the tokens. Service providers are disabled by default.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// RelayStatePrefix is the prefix of the RelayState of the SAML ECP
+	// assertions. It defaults to the one configured in the cloud.
+	RelayStatePrefix string `json:"relay_state_prefix,omitempty"`
+}
+
+// ToServiceProviderCreateMap formats a CreateServiceProviderOpts into a create
+// request.
+func (opts CreateServiceProviderOpts) ToServiceProviderCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "service_provider")
+}
+
+// CreateServiceProvider registers a service provider with the given ID.
+func CreateServiceProvider(client *gophercloud.ServiceClient, spID string, opts CreateServiceProviderOptsBuilder) (r CreateServiceProviderResult) {
+	b, err := opts.ToServiceProviderCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Put(serviceProviderURL(client, spID), &b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// GetServiceProvider retrieves details on a single service provider, by ID.
+func GetServiceProvider(client *gophercloud.ServiceClient, spID string) (r GetServiceProviderResult) {
+	resp, err := client.Get(serviceProviderURL(client, spID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateServiceProviderOptsBuilder allows extensions to add additional
+// parameters to the UpdateServiceProvider request.
+type UpdateServiceProviderOptsBuilder interface {
+	ToServiceProviderUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateServiceProviderOpts provides options for updating a service provider.
+type UpdateServiceProviderOpts struct {
+	// AuthURL is the URL to authenticate to the service provider with an
+	// assertion of this cloud.
+	AuthURL string `json:"auth_url,omitempty"`
+
+	// SPURL is the URL the assertions are posted to.
+	SPURL string `json:"sp_url,omitempty"`
+
+	// Description is a description of the service provider.
+	Description *string `json:"description,omitempty"`
+
+	// Enabled tells whether the service provider is listed in the service
+	// catalog of the tokens.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// RelayStatePrefix is the prefix of the RelayState of the SAML ECP
+	// assertions.
+	RelayStatePrefix string `json:"relay_state_prefix,omitempty"`
+}
+
+// ToServiceProviderUpdateMap formats an UpdateServiceProviderOpts into an
+// update request.
+func (opts UpdateServiceProviderOpts) ToServiceProviderUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "service_provider")
+}
+
+// UpdateServiceProvider updates an existing service provider.
+func UpdateServiceProvider(client *gophercloud.ServiceClient, spID string, opts UpdateServiceProviderOptsBuilder) (r UpdateServiceProviderResult) {
+	b, err := opts.ToServiceProviderUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Patch(serviceProviderURL(client, spID), &b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// DeleteServiceProvider deletes a service provider.
+func DeleteServiceProvider(client *gophercloud.ServiceClient, spID string) (r DeleteServiceProviderResult) {
+	resp, err := client.Delete(serviceProviderURL(client, spID), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
@@ -0,0 +1,502 @@
+package federation
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// IdentityProvider is an external identity provider trusted by Keystone.
+type IdentityProvider struct {
+	// ID is the unique ID of the identity provider.
+	ID string `json:"id"`
+
+	// DomainID is the ID of the domain the users of the identity provider
+	// are created in.
+	DomainID string `json:"domain_id"`
+
+	// Description is a description of the identity provider.
+	Description string `json:"description"`
+
+	// Enabled tells whether users of the identity provider can
+	// authenticate.
+	Enabled bool `json:"enabled"`
+
+	// RemoteIDs are the IDs the identity provider is known as in the
+	// assertions, such as the entity ID of a SAML provider or the issuer of
+	// an OpenID Connect provider.
+	RemoteIDs []string `json:"remote_ids"`
+
+	// AuthorizationTTL is the number of minutes the group memberships
+	// obtained through the identity provider are kept. It is nil if the
+	// default of the cloud applies.
+	AuthorizationTTL *int `json:"authorization_ttl"`
+
+	// Links contains referencing links to the identity provider.
+	Links map[string]interface{} `json:"links"`
+}
+
+// Protocol is the way an identity provider authenticates users, along with
+// the mapping applied to its assertions.
+type Protocol struct {
+	// ID is the name of the protocol, e.g. "saml2" or "openid".
+	ID string `json:"id"`
+
+	// MappingID is the ID of the mapping applied to the assertions.
+	MappingID string `json:"mapping_id"`
+
+	// RemoteIDAttribute is the attribute of the assertion holding the
+	// remote ID of the identity provider.
+	RemoteIDAttribute string `json:"remote_id_attribute"`
+
+	// Links contains referencing links to the protocol.
+	Links map[string]interface{} `json:"links"`
+}
+
+// Mapping is a set of rules turning the attributes of an assertion into a
+// local user, groups and projects.
+type Mapping struct {
+	// ID is the unique ID of the mapping.
+	ID string `json:"id"`
+
+	// Rules are the rules of the mapping.
+	Rules []MappingRule `json:"rules"`
+
+	// SchemaVersion is the version of the schema of the rules.
+	SchemaVersion string `json:"schema_version,omitempty"`
+
+	// Links contains referencing links to the mapping.
+	Links map[string]interface{} `json:"links"`
+}
+
+// MappingRule maps assertions satisfying all of its Remote requirements to
+// its Local properties.
+type MappingRule struct {
+	// Local are the properties of the mapped user. They may refer to the
+	// values of the Remote requirements without conditions, e.g. "{0}" for
+	// the first of them.
+	Local []RuleLocal `json:"local"`
+
+	// Remote are the requirements on the attributes of the assertion.
+	Remote []RuleRemote `json:"remote"`
+}
+
+// UserType is the type of a mapped user.
+type UserType string
+
+const (
+	// UserTypeEphemeral users only exist while they are authenticated. It
+	// is the default.
+	UserTypeEphemeral UserType = "ephemeral"
+
+	// UserTypeLocal users are existing users of Keystone.
+	UserTypeLocal UserType = "local"
+)
+
+// RuleLocal is a property of the mapped user. Usually only one of the fields
+// is set.
+type RuleLocal struct {
+	// User is the mapped user.
+	User *RuleUser `json:"user,omitempty"`
+
+	// Group is a group the mapped user belongs to.
+	Group *RuleGroup `json:"group,omitempty"`
+
+	// Groups is a list of names of groups of Domain the mapped user belongs
+	// to, separated by semicolons or as a JSON list, usually a reference to
+	// a remote requirement.
+	Groups string `json:"groups,omitempty"`
+
+	// GroupIDs is a list of IDs of groups the mapped user belongs to,
+	// separated by semicolons or as a JSON list.
+	GroupIDs string `json:"group_ids,omitempty"`
+
+	// Domain is the domain of Groups.
+	Domain *RuleDomain `json:"domain,omitempty"`
+
+	// Projects are projects created if needed, on which the mapped user is
+	// granted roles.
+	Projects []RuleProject `json:"projects,omitempty"`
+}
+
+// RuleUser is the mapped user.
+type RuleUser struct {
+	ID     string      `json:"id,omitempty"`
+	Name   string      `json:"name,omitempty"`
+	Email  string      `json:"email,omitempty"`
+	Type   UserType    `json:"type,omitempty"`
+	Domain *RuleDomain `json:"domain,omitempty"`
+}
+
+// RuleDomain is a domain, by ID or by name.
+type RuleDomain struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// RuleGroup is a group, by ID, or by name along with its domain.
+type RuleGroup struct {
+	ID     string      `json:"id,omitempty"`
+	Name   string      `json:"name,omitempty"`
+	Domain *RuleDomain `json:"domain,omitempty"`
+}
+
+// RuleProject is a project on which the mapped user is granted Roles.
+type RuleProject struct {
+	Name  string     `json:"name"`
+	Roles []RuleRole `json:"roles"`
+}
+
+// RuleRole is a role, by name.
+type RuleRole struct {
+	Name string `json:"name"`
+}
+
+// RuleRemote is a requirement on an attribute of the assertion. With neither
+// AnyOneOf nor NotAnyOf, the values of the attribute can be referred to by
+// the local properties of the rule, filtered by Whitelist or Blacklist.
+type RuleRemote struct {
+	// Type is the name of the attribute. The rule doesn't apply if the
+	// assertion doesn't have it.
+	Type string `json:"type"`
+
+	// AnyOneOf requires one of the values of the attribute to be one of
+	// these.
+	AnyOneOf []string `json:"any_one_of,omitempty"`
+
+	// NotAnyOf requires no value of the attribute to be one of these.
+	NotAnyOf []string `json:"not_any_of,omitempty"`
+
+	// Regex makes AnyOneOf and NotAnyOf regular expressions, matching
+	// anywhere in the values.
+	Regex bool `json:"regex,omitempty"`
+
+	// Whitelist keeps only these values of the attribute.
+	Whitelist []string `json:"whitelist,omitempty"`
+
+	// Blacklist removes these values from the values of the attribute.
+	Blacklist []string `json:"blacklist,omitempty"`
+}
+
+// ServiceProvider is another cloud the users of this one can authenticate
+// to, through Keystone to Keystone federation.
+type ServiceProvider struct {
+	// ID is the unique ID of the service provider.
+	ID string `json:"id"`
+
+	// AuthURL is the URL to authenticate to the service provider with an
+	// assertion of this cloud.
+	AuthURL string `json:"auth_url"`
+
+	// SPURL is the URL the assertions are posted to.
+	SPURL string `json:"sp_url"`
+
+	// Description is a description of the service provider.
+	Description string `json:"description"`
+
+	// Enabled tells whether the service provider is listed in the service
+	// catalog of the tokens.
+	Enabled bool `json:"enabled"`
+
+	// RelayStatePrefix is the prefix of the RelayState of the SAML ECP
+	// assertions.
+	RelayStatePrefix string `json:"relay_state_prefix"`
+
+	// Links contains referencing links to the service provider.
+	Links map[string]interface{} `json:"links"`
+}
+
+type identityProviderResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets any identity provider result as an IdentityProvider.
+func (r identityProviderResult) Extract() (*IdentityProvider, error) {
+	var s struct {
+		IdentityProvider *IdentityProvider `json:"identity_provider"`
+	}
+	err := r.ExtractInto(&s)
+	return s.IdentityProvider, err
+}
+
+// CreateIdentityProviderResult is the response from a CreateIdentityProvider
+// operation. Call its Extract method to interpret it as an IdentityProvider.
+type CreateIdentityProviderResult struct {
+	identityProviderResult
+}
+
+// GetIdentityProviderResult is the response from a GetIdentityProvider
+// operation. Call its Extract method to interpret it as an IdentityProvider.
+type GetIdentityProviderResult struct {
+	identityProviderResult
+}
+
+// UpdateIdentityProviderResult is the response from an UpdateIdentityProvider
+// operation. Call its Extract method to interpret it as an IdentityProvider.
+type UpdateIdentityProviderResult struct {
+	identityProviderResult
+}
+
+// DeleteIdentityProviderResult is the response from a DeleteIdentityProvider
+// operation. Call its ExtractErr to determine if the request succeeded or
+// failed.
+type DeleteIdentityProviderResult struct {
+	gophercloud.ErrResult
+}
+
+// IdentityProviderPage is a single page of IdentityProvider results.
+type IdentityProviderPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty determines whether or not an IdentityProviderPage contains any
+// results.
+func (r IdentityProviderPage) IsEmpty() (bool, error) {
+	identityProviders, err := ExtractIdentityProviders(r)
+	return len(identityProviders) == 0, err
+}
+
+// NextPageURL extracts the "next" link from the links section of the result.
+func (r IdentityProviderPage) NextPageURL() (string, error) {
+	var s struct {
+		Links struct {
+			Next     string `json:"next"`
+			Previous string `json:"previous"`
+		} `json:"links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return s.Links.Next, err
+}
+
+// ExtractIdentityProviders returns a slice of IdentityProviders contained in
+// a single page of results.
+func ExtractIdentityProviders(r pagination.Page) ([]IdentityProvider, error) {
+	var s struct {
+		IdentityProviders []IdentityProvider `json:"identity_providers"`
+	}
+	err := (r.(IdentityProviderPage)).ExtractInto(&s)
+	return s.IdentityProviders, err
+}
+
+type protocolResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets any protocol result as a Protocol.
+func (r protocolResult) Extract() (*Protocol, error) {
+	var s struct {
+		Protocol *Protocol `json:"protocol"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Protocol, err
+}
+
+// CreateProtocolResult is the response from a CreateProtocol operation. Call
+// its Extract method to interpret it as a Protocol.
+type CreateProtocolResult struct {
+	protocolResult
+}
+
+// GetProtocolResult is the response from a GetProtocol operation. Call its
+// Extract method to interpret it as a Protocol.
+type GetProtocolResult struct {
+	protocolResult
+}
+
+// UpdateProtocolResult is the response from an UpdateProtocol operation. Call
+// its Extract method to interpret it as a Protocol.
+type UpdateProtocolResult struct {
+	protocolResult
+}
+
+// DeleteProtocolResult is the response from a DeleteProtocol operation. Call
+// its ExtractErr to determine if the request succeeded or failed.
+type DeleteProtocolResult struct {
+	gophercloud.ErrResult
+}
+
+// ProtocolPage is a single page of Protocol results.
+type ProtocolPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty determines whether or not a ProtocolPage contains any results.
+func (r ProtocolPage) IsEmpty() (bool, error) {
+	protocols, err := ExtractProtocols(r)
+	return len(protocols) == 0, err
+}
+
+// NextPageURL extracts the "next" link from the links section of the result.
+func (r ProtocolPage) NextPageURL() (string, error) {
+	var s struct {
+		Links struct {
+			Next     string `json:"next"`
+			Previous string `json:"previous"`
+		} `json:"links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return s.Links.Next, err
+}
+
+// ExtractProtocols returns a slice of Protocols contained in a single page of
+// results.
+func ExtractProtocols(r pagination.Page) ([]Protocol, error) {
+	var s struct {
+		Protocols []Protocol `json:"protocols"`
+	}
+	err := (r.(ProtocolPage)).ExtractInto(&s)
+	return s.Protocols, err
+}
+
+type mappingResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets any mapping result as a Mapping.
+func (r mappingResult) Extract() (*Mapping, error) {
+	var s struct {
+		Mapping *Mapping `json:"mapping"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Mapping, err
+}
+
+// CreateMappingResult is the response from a CreateMapping operation. Call
+// its Extract method to interpret it as a Mapping.
+type CreateMappingResult struct {
+	mappingResult
+}
+
+// GetMappingResult is the response from a GetMapping operation. Call its
+// Extract method to interpret it as a Mapping.
+type GetMappingResult struct {
+	mappingResult
+}
+
+// UpdateMappingResult is the response from an UpdateMapping operation. Call
+// its Extract method to interpret it as a Mapping.
+type UpdateMappingResult struct {
+	mappingResult
+}
+
+// DeleteMappingResult is the response from a DeleteMapping operation. Call
+// its ExtractErr to determine if the request succeeded or failed.
+type DeleteMappingResult struct {
+	gophercloud.ErrResult
+}
+
+// MappingPage is a single page of Mapping results.
+type MappingPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty determines whether or not a MappingPage contains any results.
+func (r MappingPage) IsEmpty() (bool, error) {
+	mappings, err := ExtractMappings(r)
+	return len(mappings) == 0, err
+}
+
+// NextPageURL extracts the "next" link from the links section of the result.
+func (r MappingPage) NextPageURL() (string, error) {
+	var s struct {
+		Links struct {
+			Next     string `json:"next"`
+			Previous string `json:"previous"`
+		} `json:"links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return s.Links.Next, err
+}
+
+// ExtractMappings returns a slice of Mappings contained in a single page of
+// results.
+func ExtractMappings(r pagination.Page) ([]Mapping, error) {
+	var s struct {
+		Mappings []Mapping `json:"mappings"`
+	}
+	err := (r.(MappingPage)).ExtractInto(&s)
+	return s.Mappings, err
+}
+
+type serviceProviderResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets any service provider result as a ServiceProvider.
+func (r serviceProviderResult) Extract() (*ServiceProvider, error) {
+	var s struct {
+		ServiceProvider *ServiceProvider `json:"service_provider"`
+	}
+	err := r.ExtractInto(&s)
+	return s.ServiceProvider, err
+}
+
+// CreateServiceProviderResult is the response from a CreateServiceProvider
+// operation. Call its Extract method to interpret it as a ServiceProvider.
+type CreateServiceProviderResult struct {
+	serviceProviderResult
+}
+
+// GetServiceProviderResult is the response from a GetServiceProvider
+// operation. Call its Extract method to interpret it as a ServiceProvider.
+type GetServiceProviderResult struct {
+	serviceProviderResult
+}
+
+// UpdateServiceProviderResult is the response from an UpdateServiceProvider
+// operation. Call its Extract method to interpret it as a ServiceProvider.
+type UpdateServiceProviderResult struct {
+	serviceProviderResult
+}
+
+// DeleteServiceProviderResult is the response from a DeleteServiceProvider
+// operation. Call its ExtractErr to determine if the request succeeded or
+// failed.
+type DeleteServiceProviderResult struct {
+	gophercloud.ErrResult
+}
+
+// ServiceProviderPage is a single page of ServiceProvider results.
+type ServiceProviderPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty determines whether or not a ServiceProviderPage contains any
+// results.
+func (r ServiceProviderPage) IsEmpty() (bool, error) {
+	serviceProviders, err := ExtractServiceProviders(r)
+	return len(serviceProviders) == 0, err
+}
+
+// NextPageURL extracts the "next" link from the links section of the result.
+func (r ServiceProviderPage) NextPageURL() (string, error) {
+	var s struct {
+		Links struct {
+			Next     string `json:"next"`
+			Previous string `json:"previous"`
+		} `json:"links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return s.Links.Next, err
+}
+
+// ExtractServiceProviders returns a slice of ServiceProviders contained in a
+// single page of results.
+func ExtractServiceProviders(r pagination.Page) ([]ServiceProvider, error) {
+	var s struct {
+		ServiceProviders []ServiceProvider `json:"service_providers"`
+	}
+	err := (r.(ServiceProviderPage)).ExtractInto(&s)
+	return s.ServiceProviders, err
+}
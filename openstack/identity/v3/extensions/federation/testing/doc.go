@@ -0,0 +1,2 @@
+// federation unit tests
+package testing
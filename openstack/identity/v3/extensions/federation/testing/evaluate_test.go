@@ -0,0 +1,166 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/extensions/federation"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestEvaluateMapping(t *testing.T) {
+	props, err := SSOMapping.Evaluate(federation.Assertion{
+		"OIDC-preferred_username": {"jdoe"},
+		"OIDC-email":              {"jdoe@example.com"},
+		"OIDC-groups":             {"developers", "marketing", "operators"},
+	})
+	th.AssertNoErr(t, err)
+
+	domain := &federation.RuleDomain{ID: "c7dfdd4e7a394c1d87c3c9a0c5a7d8b2"}
+	expected := &federation.MappedProperties{
+		User: &federation.RuleUser{
+			Name:  "jdoe",
+			Email: "jdoe@example.com",
+			Type:  federation.UserTypeEphemeral,
+		},
+		Groups: []federation.RuleGroup{
+			{Name: "developers", Domain: domain},
+			{Name: "operators", Domain: domain},
+		},
+	}
+	th.CheckDeepEquals(t, expected, props)
+
+	// The regular expression on the email isn't satisfied.
+	_, err = SSOMapping.Evaluate(federation.Assertion{
+		"OIDC-preferred_username": {"mallory"},
+		"OIDC-email":              {"mallory@example.org"},
+		"OIDC-groups":             {"developers"},
+	})
+	if _, ok := err.(federation.ErrNoMatchingRule); !ok {
+		t.Fatalf("Expected ErrNoMatchingRule, got %T: %v", err, err)
+	}
+
+	// An attribute is missing.
+	_, err = SSOMapping.Evaluate(federation.Assertion{
+		"OIDC-preferred_username": {"jdoe"},
+		"OIDC-email":              {"jdoe@example.com"},
+	})
+	if _, ok := err.(federation.ErrNoMatchingRule); !ok {
+		t.Fatalf("Expected ErrNoMatchingRule, got %T: %v", err, err)
+	}
+}
+
+func TestEvaluateRules(t *testing.T) {
+	rules := []federation.MappingRule{
+		{
+			Local: []federation.RuleLocal{
+				{
+					User: &federation.RuleUser{
+						Name:   "{0}",
+						Type:   federation.UserTypeLocal,
+						Domain: &federation.RuleDomain{Name: "Default"},
+					},
+				},
+				{GroupIDs: "{1}"},
+			},
+			Remote: []federation.RuleRemote{
+				{Type: "REMOTE_USER"},
+				{Type: "ADFS_GROUP_IDS"},
+				{Type: "ADFS_ROLE", NotAnyOf: []string{"Contractor", "Guest"}},
+			},
+		},
+		{
+			Local: []federation.RuleLocal{
+				{
+					User: &federation.RuleUser{Name: "ignored"},
+				},
+				{
+					Group: &federation.RuleGroup{
+						Name:   "{{admins}} {0}",
+						Domain: &federation.RuleDomain{ID: "default"},
+					},
+				},
+				{
+					Projects: []federation.RuleProject{
+						{Name: "{0}-sandbox", Roles: []federation.RuleRole{{Name: "member"}}},
+					},
+				},
+			},
+			Remote: []federation.RuleRemote{
+				{Type: "REMOTE_USER"},
+				{Type: "ADFS_ROLE", AnyOneOf: []string{"^Admin"}, Regex: true},
+			},
+		},
+		{
+			Local: []federation.RuleLocal{
+				{
+					Group: &federation.RuleGroup{ID: "3f9cb1b6"},
+				},
+				{
+					Projects: []federation.RuleProject{
+						{Name: "{0}-sandbox", Roles: []federation.RuleRole{{Name: "member"}, {Name: "reader"}}},
+					},
+				},
+			},
+			Remote: []federation.RuleRemote{
+				{Type: "REMOTE_USER", Blacklist: []string{"root"}},
+			},
+		},
+	}
+
+	props, err := federation.EvaluateRules(rules, federation.Assertion{
+		"REMOTE_USER":    {"jdoe"},
+		"ADFS_GROUP_IDS": {"3f9cb1b6", "8a2e7c40"},
+		"ADFS_ROLE":      {"Employee", "Administrator"},
+	})
+	th.AssertNoErr(t, err)
+
+	expected := &federation.MappedProperties{
+		User: &federation.RuleUser{
+			Name:   "jdoe",
+			Type:   federation.UserTypeLocal,
+			Domain: &federation.RuleDomain{Name: "Default"},
+		},
+		GroupIDs: []string{"3f9cb1b6", "8a2e7c40"},
+		Groups: []federation.RuleGroup{
+			{Name: "{admins} jdoe", Domain: &federation.RuleDomain{ID: "default"}},
+		},
+		Projects: []federation.RuleProject{
+			{Name: "jdoe-sandbox", Roles: []federation.RuleRole{{Name: "member"}, {Name: "reader"}}},
+		},
+	}
+	th.CheckDeepEquals(t, expected, props)
+
+	// The first rule doesn't apply to contractors, and no rule maps a user.
+	props, err = federation.EvaluateRules(rules, federation.Assertion{
+		"REMOTE_USER":    {"jdoe"},
+		"ADFS_GROUP_IDS": {"8a2e7c40"},
+		"ADFS_ROLE":      {"Contractor"},
+	})
+	th.AssertNoErr(t, err)
+	if props.User != nil {
+		t.Errorf("Expected no user, got %+v", props.User)
+	}
+	th.CheckDeepEquals(t, []string{"3f9cb1b6"}, props.GroupIDs)
+}
+
+func TestEvaluateRulesInvalidDirectMapping(t *testing.T) {
+	rules := []federation.MappingRule{
+		{
+			Local: []federation.RuleLocal{
+				{User: &federation.RuleUser{Name: "{1}"}},
+			},
+			Remote: []federation.RuleRemote{
+				{Type: "REMOTE_USER"},
+				{Type: "REMOTE_USER", AnyOneOf: []string{"jdoe"}},
+			},
+		},
+	}
+
+	_, err := federation.EvaluateRules(rules, federation.Assertion{"REMOTE_USER": {"jdoe"}})
+	e, ok := err.(federation.ErrDirectMappingIndex)
+	if !ok {
+		t.Fatalf("Expected ErrDirectMappingIndex, got %T: %v", err, err)
+	}
+	th.CheckEquals(t, 1, e.Index)
+	th.CheckEquals(t, 1, e.Count)
+}
@@ -0,0 +1,615 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/extensions/federation"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// ListIdentityProvidersOutput provides a single page of identity providers.
+const ListIdentityProvidersOutput = `
+{
+    "identity_providers": [
+        {
+            "id": "sso",
+            "domain_id": "c7dfdd4e7a394c1d87c3c9a0c5a7d8b2",
+            "description": "Company SSO",
+            "enabled": true,
+            "remote_ids": ["https://accounts.example.com"],
+            "authorization_ttl": null,
+            "links": {
+                "self": "https://example.com/identity/v3/OS-FEDERATION/identity_providers/sso",
+                "protocols": "https://example.com/identity/v3/OS-FEDERATION/identity_providers/sso/protocols"
+            }
+        },
+        {
+            "id": "partner",
+            "domain_id": "a2e8c1c4b5a14f6fbb7e3b5f6e0c9d1a",
+            "description": "",
+            "enabled": false,
+            "remote_ids": [],
+            "authorization_ttl": 60,
+            "links": {
+                "self": "https://example.com/identity/v3/OS-FEDERATION/identity_providers/partner",
+                "protocols": "https://example.com/identity/v3/OS-FEDERATION/identity_providers/partner/protocols"
+            }
+        }
+    ],
+    "links": {
+        "next": null,
+        "previous": null,
+        "self": "https://example.com/identity/v3/OS-FEDERATION/identity_providers"
+    }
+}
+`
+
+// GetIdentityProviderOutput provides a Get result.
+const GetIdentityProviderOutput = `
+{
+    "identity_provider": {
+        "id": "sso",
+        "domain_id": "c7dfdd4e7a394c1d87c3c9a0c5a7d8b2",
+        "description": "Company SSO",
+        "enabled": true,
+        "remote_ids": ["https://accounts.example.com"],
+        "authorization_ttl": null,
+        "links": {
+            "self": "https://example.com/identity/v3/OS-FEDERATION/identity_providers/sso",
+            "protocols": "https://example.com/identity/v3/OS-FEDERATION/identity_providers/sso/protocols"
+        }
+    }
+}
+`
+
+// CreateIdentityProviderRequest provides the input to a
+// CreateIdentityProvider request.
+const CreateIdentityProviderRequest = `
+{
+    "identity_provider": {
+        "domain_id": "c7dfdd4e7a394c1d87c3c9a0c5a7d8b2",
+        "description": "Company SSO",
+        "enabled": true,
+        "remote_ids": ["https://accounts.example.com"]
+    }
+}
+`
+
+// UpdateIdentityProviderRequest provides the input to an
+// UpdateIdentityProvider request.
+const UpdateIdentityProviderRequest = `
+{
+    "identity_provider": {
+        "description": "",
+        "enabled": false,
+        "authorization_ttl": 60
+    }
+}
+`
+
+// UpdateIdentityProviderOutput provides an UpdateIdentityProvider result.
+const UpdateIdentityProviderOutput = `
+{
+    "identity_provider": {
+        "id": "sso",
+        "domain_id": "c7dfdd4e7a394c1d87c3c9a0c5a7d8b2",
+        "description": "",
+        "enabled": false,
+        "remote_ids": ["https://accounts.example.com"],
+        "authorization_ttl": 60,
+        "links": {
+            "self": "https://example.com/identity/v3/OS-FEDERATION/identity_providers/sso",
+            "protocols": "https://example.com/identity/v3/OS-FEDERATION/identity_providers/sso/protocols"
+        }
+    }
+}
+`
+
+// ListProtocolsOutput provides a single page of protocols.
+const ListProtocolsOutput = `
+{
+    "protocols": [
+        {
+            "id": "openid",
+            "mapping_id": "sso-mapping",
+            "remote_id_attribute": "HTTP_OIDC_ISS",
+            "links": {
+                "identity_provider": "https://example.com/identity/v3/OS-FEDERATION/identity_providers/sso",
+                "self": "https://example.com/identity/v3/OS-FEDERATION/identity_providers/sso/protocols/openid"
+            }
+        }
+    ],
+    "links": {
+        "next": null,
+        "previous": null,
+        "self": "https://example.com/identity/v3/OS-FEDERATION/identity_providers/sso/protocols"
+    }
+}
+`
+
+// CreateProtocolRequest provides the input to a CreateProtocol request.
+const CreateProtocolRequest = `
+{
+    "protocol": {
+        "mapping_id": "sso-mapping",
+        "remote_id_attribute": "HTTP_OIDC_ISS"
+    }
+}
+`
+
+// GetProtocolOutput provides a CreateProtocol result.
+const GetProtocolOutput = `
+{
+    "protocol": {
+        "id": "openid",
+        "mapping_id": "sso-mapping",
+        "remote_id_attribute": "HTTP_OIDC_ISS",
+        "links": {
+            "identity_provider": "https://example.com/identity/v3/OS-FEDERATION/identity_providers/sso",
+            "self": "https://example.com/identity/v3/OS-FEDERATION/identity_providers/sso/protocols/openid"
+        }
+    }
+}
+`
+
+// ListMappingsOutput provides a single page of mappings.
+const ListMappingsOutput = `
+{
+    "mappings": [
+        {
+            "id": "sso-mapping",
+            "schema_version": "1.0",
+            "rules": [
+                {
+                    "local": [
+                        {
+                            "user": {
+                                "name": "{0}",
+                                "email": "{1}"
+                            }
+                        },
+                        {
+                            "groups": "{2}",
+                            "domain": {
+                                "id": "c7dfdd4e7a394c1d87c3c9a0c5a7d8b2"
+                            }
+                        }
+                    ],
+                    "remote": [
+                        {
+                            "type": "OIDC-preferred_username"
+                        },
+                        {
+                            "type": "OIDC-email"
+                        },
+                        {
+                            "type": "OIDC-groups",
+                            "whitelist": ["developers", "operators"]
+                        },
+                        {
+                            "type": "OIDC-email",
+                            "any_one_of": [".*@example\\.com$"],
+                            "regex": true
+                        }
+                    ]
+                }
+            ],
+            "links": {
+                "self": "https://example.com/identity/v3/OS-FEDERATION/mappings/sso-mapping"
+            }
+        }
+    ],
+    "links": {
+        "next": null,
+        "previous": null,
+        "self": "https://example.com/identity/v3/OS-FEDERATION/mappings"
+    }
+}
+`
+
+// CreateMappingRequest provides the input to a CreateMapping request.
+const CreateMappingRequest = `
+{
+    "mapping": {
+        "rules": [
+            {
+                "local": [
+                    {
+                        "user": {
+                            "name": "{0}",
+                            "email": "{1}"
+                        }
+                    },
+                    {
+                        "groups": "{2}",
+                        "domain": {
+                            "id": "c7dfdd4e7a394c1d87c3c9a0c5a7d8b2"
+                        }
+                    }
+                ],
+                "remote": [
+                    {
+                        "type": "OIDC-preferred_username"
+                    },
+                    {
+                        "type": "OIDC-email"
+                    },
+                    {
+                        "type": "OIDC-groups",
+                        "whitelist": ["developers", "operators"]
+                    },
+                    {
+                        "type": "OIDC-email",
+                        "any_one_of": [".*@example\\.com$"],
+                        "regex": true
+                    }
+                ]
+            }
+        ]
+    }
+}
+`
+
+// GetMappingOutput provides a Get result.
+const GetMappingOutput = `
+{
+    "mapping": {
+        "id": "sso-mapping",
+        "schema_version": "1.0",
+        "rules": [
+            {
+                "local": [
+                    {
+                        "user": {
+                            "name": "{0}",
+                            "email": "{1}"
+                        }
+                    },
+                    {
+                        "groups": "{2}",
+                        "domain": {
+                            "id": "c7dfdd4e7a394c1d87c3c9a0c5a7d8b2"
+                        }
+                    }
+                ],
+                "remote": [
+                    {
+                        "type": "OIDC-preferred_username"
+                    },
+                    {
+                        "type": "OIDC-email"
+                    },
+                    {
+                        "type": "OIDC-groups",
+                        "whitelist": ["developers", "operators"]
+                    },
+                    {
+                        "type": "OIDC-email",
+                        "any_one_of": [".*@example\\.com$"],
+                        "regex": true
+                    }
+                ]
+            }
+        ],
+        "links": {
+            "self": "https://example.com/identity/v3/OS-FEDERATION/mappings/sso-mapping"
+        }
+    }
+}
+`
+
+// ListServiceProvidersOutput provides a single page of service providers.
+const ListServiceProvidersOutput = `
+{
+    "service_providers": [
+        {
+            "id": "burst",
+            "auth_url": "https://burst.example.com:5000/v3/OS-FEDERATION/identity_providers/main/protocols/saml2/auth",
+            "sp_url": "https://burst.example.com:5000/Shibboleth.sso/SAML2/ECP",
+            "description": "Burst capacity",
+            "enabled": true,
+            "relay_state_prefix": "ss:mem:",
+            "links": {
+                "self": "https://example.com/identity/v3/OS-FEDERATION/service_providers/burst"
+            }
+        }
+    ],
+    "links": {
+        "next": null,
+        "previous": null,
+        "self": "https://example.com/identity/v3/OS-FEDERATION/service_providers"
+    }
+}
+`
+
+// CreateServiceProviderRequest provides the input to a CreateServiceProvider
+// request.
+const CreateServiceProviderRequest = `
+{
+    "service_provider": {
+        "auth_url": "https://burst.example.com:5000/v3/OS-FEDERATION/identity_providers/main/protocols/saml2/auth",
+        "sp_url": "https://burst.example.com:5000/Shibboleth.sso/SAML2/ECP",
+        "description": "Burst capacity",
+        "enabled": true
+    }
+}
+`
+
+// UpdateServiceProviderRequest provides the input to an
+// UpdateServiceProvider request.
+const UpdateServiceProviderRequest = `
+{
+    "service_provider": {
+        "enabled": false
+    }
+}
+`
+
+// GetServiceProviderOutput provides a CreateServiceProvider result.
+const GetServiceProviderOutput = `
+{
+    "service_provider": {
+        "id": "burst",
+        "auth_url": "https://burst.example.com:5000/v3/OS-FEDERATION/identity_providers/main/protocols/saml2/auth",
+        "sp_url": "https://burst.example.com:5000/Shibboleth.sso/SAML2/ECP",
+        "description": "Burst capacity",
+        "enabled": true,
+        "relay_state_prefix": "ss:mem:",
+        "links": {
+            "self": "https://example.com/identity/v3/OS-FEDERATION/service_providers/burst"
+        }
+    }
+}
+`
+
+// UpdateServiceProviderOutput provides an UpdateServiceProvider result.
+const UpdateServiceProviderOutput = `
+{
+    "service_provider": {
+        "id": "burst",
+        "auth_url": "https://burst.example.com:5000/v3/OS-FEDERATION/identity_providers/main/protocols/saml2/auth",
+        "sp_url": "https://burst.example.com:5000/Shibboleth.sso/SAML2/ECP",
+        "description": "Burst capacity",
+        "enabled": false,
+        "relay_state_prefix": "ss:mem:",
+        "links": {
+            "self": "https://example.com/identity/v3/OS-FEDERATION/service_providers/burst"
+        }
+    }
+}
+`
+
+var authorizationTTL = 60
+
+// SSOIdentityProvider is the first identity provider in the List request.
+var SSOIdentityProvider = federation.IdentityProvider{
+	ID:          "sso",
+	DomainID:    "c7dfdd4e7a394c1d87c3c9a0c5a7d8b2",
+	Description: "Company SSO",
+	Enabled:     true,
+	RemoteIDs:   []string{"https://accounts.example.com"},
+	Links: map[string]interface{}{
+		"self":      "https://example.com/identity/v3/OS-FEDERATION/identity_providers/sso",
+		"protocols": "https://example.com/identity/v3/OS-FEDERATION/identity_providers/sso/protocols",
+	},
+}
+
+// PartnerIdentityProvider is the second identity provider in the List
+// request.
+var PartnerIdentityProvider = federation.IdentityProvider{
+	ID:               "partner",
+	DomainID:         "a2e8c1c4b5a14f6fbb7e3b5f6e0c9d1a",
+	RemoteIDs:        []string{},
+	AuthorizationTTL: &authorizationTTL,
+	Links: map[string]interface{}{
+		"self":      "https://example.com/identity/v3/OS-FEDERATION/identity_providers/partner",
+		"protocols": "https://example.com/identity/v3/OS-FEDERATION/identity_providers/partner/protocols",
+	},
+}
+
+// ExpectedIdentityProvidersSlice is the slice of identity providers expected
+// to be returned from ListIdentityProvidersOutput.
+var ExpectedIdentityProvidersSlice = []federation.IdentityProvider{SSOIdentityProvider, PartnerIdentityProvider}
+
+// OpenIDProtocol is the protocol in the List request.
+var OpenIDProtocol = federation.Protocol{
+	ID:                "openid",
+	MappingID:         "sso-mapping",
+	RemoteIDAttribute: "HTTP_OIDC_ISS",
+	Links: map[string]interface{}{
+		"identity_provider": "https://example.com/identity/v3/OS-FEDERATION/identity_providers/sso",
+		"self":              "https://example.com/identity/v3/OS-FEDERATION/identity_providers/sso/protocols/openid",
+	},
+}
+
+// SSORules are the rules of SSOMapping.
+var SSORules = []federation.MappingRule{
+	{
+		Local: []federation.RuleLocal{
+			{
+				User: &federation.RuleUser{
+					Name:  "{0}",
+					Email: "{1}",
+				},
+			},
+			{
+				Groups: "{2}",
+				Domain: &federation.RuleDomain{ID: "c7dfdd4e7a394c1d87c3c9a0c5a7d8b2"},
+			},
+		},
+		Remote: []federation.RuleRemote{
+			{Type: "OIDC-preferred_username"},
+			{Type: "OIDC-email"},
+			{
+				Type:      "OIDC-groups",
+				Whitelist: []string{"developers", "operators"},
+			},
+			{
+				Type:     "OIDC-email",
+				AnyOneOf: []string{`.*@example\.com$`},
+				Regex:    true,
+			},
+		},
+	},
+}
+
+// SSOMapping is the mapping in the List request.
+var SSOMapping = federation.Mapping{
+	ID:            "sso-mapping",
+	SchemaVersion: "1.0",
+	Rules:         SSORules,
+	Links: map[string]interface{}{
+		"self": "https://example.com/identity/v3/OS-FEDERATION/mappings/sso-mapping",
+	},
+}
+
+// BurstServiceProvider is the service provider in the List request.
+var BurstServiceProvider = federation.ServiceProvider{
+	ID:               "burst",
+	AuthURL:          "https://burst.example.com:5000/v3/OS-FEDERATION/identity_providers/main/protocols/saml2/auth",
+	SPURL:            "https://burst.example.com:5000/Shibboleth.sso/SAML2/ECP",
+	Description:      "Burst capacity",
+	Enabled:          true,
+	RelayStatePrefix: "ss:mem:",
+	Links: map[string]interface{}{
+		"self": "https://example.com/identity/v3/OS-FEDERATION/service_providers/burst",
+	},
+}
+
+// HandleIdentityProvidersSuccessfully creates HTTP handlers at
+// `/OS-FEDERATION/identity_providers` on the test handler mux that list,
+// create, get, update and delete identity providers.
+func HandleIdentityProvidersSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-FEDERATION/identity_providers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListIdentityProvidersOutput)
+	})
+
+	th.Mux.HandleFunc("/OS-FEDERATION/identity_providers/sso", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case "PUT":
+			th.TestJSONRequest(t, r, CreateIdentityProviderRequest)
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, GetIdentityProviderOutput)
+		case "GET":
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, GetIdentityProviderOutput)
+		case "PATCH":
+			th.TestJSONRequest(t, r, UpdateIdentityProviderRequest)
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, UpdateIdentityProviderOutput)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+}
+
+// HandleProtocolsSuccessfully creates HTTP handlers for the protocols of the
+// sso identity provider on the test handler mux.
+func HandleProtocolsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-FEDERATION/identity_providers/sso/protocols", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListProtocolsOutput)
+	})
+
+	th.Mux.HandleFunc("/OS-FEDERATION/identity_providers/sso/protocols/openid", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case "PUT":
+			th.TestJSONRequest(t, r, CreateProtocolRequest)
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, GetProtocolOutput)
+		case "PATCH":
+			th.TestJSONRequest(t, r, CreateProtocolRequest)
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, GetProtocolOutput)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+}
+
+// HandleMappingsSuccessfully creates HTTP handlers at
+// `/OS-FEDERATION/mappings` on the test handler mux that list, create and get
+// mappings.
+func HandleMappingsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-FEDERATION/mappings", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListMappingsOutput)
+	})
+
+	th.Mux.HandleFunc("/OS-FEDERATION/mappings/sso-mapping", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case "PUT":
+			th.TestJSONRequest(t, r, CreateMappingRequest)
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, GetMappingOutput)
+		case "GET":
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, GetMappingOutput)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+}
+
+// HandleServiceProvidersSuccessfully creates HTTP handlers at
+// `/OS-FEDERATION/service_providers` on the test handler mux that list,
+// create and update service providers.
+func HandleServiceProvidersSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-FEDERATION/service_providers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"enabled": "true"})
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListServiceProvidersOutput)
+	})
+
+	th.Mux.HandleFunc("/OS-FEDERATION/service_providers/burst", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case "PUT":
+			th.TestJSONRequest(t, r, CreateServiceProviderRequest)
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, GetServiceProviderOutput)
+		case "PATCH":
+			th.TestJSONRequest(t, r, UpdateServiceProviderRequest)
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, UpdateServiceProviderOutput)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+}
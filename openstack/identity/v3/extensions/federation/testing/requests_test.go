@@ -0,0 +1,192 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/extensions/federation"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestListIdentityProviders(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleIdentityProvidersSuccessfully(t)
+
+	count := 0
+	err := federation.ListIdentityProviders(client.ServiceClient(), nil).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+
+		actual, err := federation.ExtractIdentityProviders(page)
+		th.AssertNoErr(t, err)
+
+		th.CheckDeepEquals(t, ExpectedIdentityProvidersSlice, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, count, 1)
+}
+
+func TestCreateIdentityProvider(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleIdentityProvidersSuccessfully(t)
+
+	enabled := true
+	createOpts := federation.CreateIdentityProviderOpts{
+		DomainID:    "c7dfdd4e7a394c1d87c3c9a0c5a7d8b2",
+		Description: "Company SSO",
+		Enabled:     &enabled,
+		RemoteIDs:   []string{"https://accounts.example.com"},
+	}
+
+	actual, err := federation.CreateIdentityProvider(client.ServiceClient(), "sso", createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, SSOIdentityProvider, *actual)
+}
+
+func TestGetIdentityProvider(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleIdentityProvidersSuccessfully(t)
+
+	actual, err := federation.GetIdentityProvider(client.ServiceClient(), "sso").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, SSOIdentityProvider, *actual)
+}
+
+func TestUpdateIdentityProvider(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleIdentityProvidersSuccessfully(t)
+
+	description := ""
+	enabled := false
+	ttl := 60
+	updateOpts := federation.UpdateIdentityProviderOpts{
+		Description:      &description,
+		Enabled:          &enabled,
+		AuthorizationTTL: &ttl,
+	}
+
+	expected := SSOIdentityProvider
+	expected.Description = ""
+	expected.Enabled = false
+	expected.AuthorizationTTL = &authorizationTTL
+
+	actual, err := federation.UpdateIdentityProvider(client.ServiceClient(), "sso", updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, expected, *actual)
+}
+
+func TestDeleteIdentityProvider(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleIdentityProvidersSuccessfully(t)
+
+	err := federation.DeleteIdentityProvider(client.ServiceClient(), "sso").ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestProtocols(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleProtocolsSuccessfully(t)
+
+	allPages, err := federation.ListProtocols(client.ServiceClient(), "sso").AllPages()
+	th.AssertNoErr(t, err)
+	protocols, err := federation.ExtractProtocols(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []federation.Protocol{OpenIDProtocol}, protocols)
+
+	opts := federation.ProtocolOpts{
+		MappingID:         "sso-mapping",
+		RemoteIDAttribute: "HTTP_OIDC_ISS",
+	}
+
+	protocol, err := federation.CreateProtocol(client.ServiceClient(), "sso", "openid", opts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, OpenIDProtocol, *protocol)
+
+	protocol, err = federation.UpdateProtocol(client.ServiceClient(), "sso", "openid", opts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, OpenIDProtocol, *protocol)
+
+	err = federation.DeleteProtocol(client.ServiceClient(), "sso", "openid").ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestCreateProtocolMissingMapping(t *testing.T) {
+	_, err := federation.CreateProtocol(client.ServiceClient(), "sso", "openid", federation.ProtocolOpts{}).Extract()
+	if _, ok := err.(gophercloud.ErrMissingInput); !ok {
+		t.Fatalf("Expected ErrMissingInput, got %T: %v", err, err)
+	}
+}
+
+func TestListMappings(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleMappingsSuccessfully(t)
+
+	allPages, err := federation.ListMappings(client.ServiceClient()).AllPages()
+	th.AssertNoErr(t, err)
+
+	actual, err := federation.ExtractMappings(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []federation.Mapping{SSOMapping}, actual)
+}
+
+func TestCreateMapping(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleMappingsSuccessfully(t)
+
+	createOpts := federation.MappingOpts{
+		Rules: SSORules,
+	}
+
+	actual, err := federation.CreateMapping(client.ServiceClient(), "sso-mapping", createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, SSOMapping, *actual)
+}
+
+func TestGetMapping(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleMappingsSuccessfully(t)
+
+	actual, err := federation.GetMapping(client.ServiceClient(), "sso-mapping").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, SSOMapping, *actual)
+}
+
+func TestServiceProviders(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServiceProvidersSuccessfully(t)
+
+	enabled := true
+	allPages, err := federation.ListServiceProviders(client.ServiceClient(), federation.ListServiceProvidersOpts{Enabled: &enabled}).AllPages()
+	th.AssertNoErr(t, err)
+	serviceProviders, err := federation.ExtractServiceProviders(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []federation.ServiceProvider{BurstServiceProvider}, serviceProviders)
+
+	createOpts := federation.CreateServiceProviderOpts{
+		AuthURL:     "https://burst.example.com:5000/v3/OS-FEDERATION/identity_providers/main/protocols/saml2/auth",
+		SPURL:       "https://burst.example.com:5000/Shibboleth.sso/SAML2/ECP",
+		Description: "Burst capacity",
+		Enabled:     &enabled,
+	}
+	serviceProvider, err := federation.CreateServiceProvider(client.ServiceClient(), "burst", createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, BurstServiceProvider, *serviceProvider)
+
+	disabled := false
+	serviceProvider, err = federation.UpdateServiceProvider(client.ServiceClient(), "burst", federation.UpdateServiceProviderOpts{Enabled: &disabled}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, false, serviceProvider.Enabled)
+}
@@ -0,0 +1,43 @@
+package federation
+
+import "github.com/gophercloud/gophercloud"
+
+const (
+	rootPath             = "OS-FEDERATION"
+	identityProviderPath = "identity_providers"
+	protocolsPath        = "protocols"
+	mappingsPath         = "mappings"
+	serviceProviderPath  = "service_providers"
+)
+
+func identityProvidersRootURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(rootPath, identityProviderPath)
+}
+
+func identityProviderURL(c *gophercloud.ServiceClient, idpID string) string {
+	return c.ServiceURL(rootPath, identityProviderPath, idpID)
+}
+
+func protocolsRootURL(c *gophercloud.ServiceClient, idpID string) string {
+	return c.ServiceURL(rootPath, identityProviderPath, idpID, protocolsPath)
+}
+
+func protocolURL(c *gophercloud.ServiceClient, idpID, protocolID string) string {
+	return c.ServiceURL(rootPath, identityProviderPath, idpID, protocolsPath, protocolID)
+}
+
+func mappingsRootURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(rootPath, mappingsPath)
+}
+
+func mappingURL(c *gophercloud.ServiceClient, mappingID string) string {
+	return c.ServiceURL(rootPath, mappingsPath, mappingID)
+}
+
+func serviceProvidersRootURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(rootPath, serviceProviderPath)
+}
+
+func serviceProviderURL(c *gophercloud.ServiceClient, spID string) string {
+	return c.ServiceURL(rootPath, serviceProviderPath, spID)
+}
@@ -0,0 +1,67 @@
+/*
+Package oidc authenticates against Keystone through federation with an OpenID
+Connect identity provider, like the v3oidcpassword, v3oidcclientcredentials
+and v3oidcaccesstoken plugins of keystoneauth.
+
+An access token is obtained from the OpenID Connect provider, unless one is
+given, then exchanged at the OS-FEDERATION auth endpoint of the identity
+provider and protocol for an unscoped Keystone token, which is finally
+rescoped if a Scope is requested.
+
+Example to Authenticate with a Username and Password
+
+	provider, err := openstack.NewClient("https://keystone.example.com:5000/v3/")
+	if err != nil {
+		panic(err)
+	}
+
+	authOpts := &oidc.AuthOptions{
+		AuthType:          oidc.AuthTypePassword,
+		IdentityProvider:  "sso",
+		Protocol:          "openid",
+		DiscoveryEndpoint: "https://sso.example.com/.well-known/openid-configuration",
+		ClientID:          "openstack",
+		ClientSecret:      "secret",
+		Username:          "jdoe",
+		Password:          "password",
+		Scope: tokens.Scope{
+			ProjectName: "demo",
+			DomainName:  "Default",
+		},
+		AllowReauth: true,
+	}
+
+	err = openstack.AuthenticateV3(provider, authOpts, gophercloud.EndpointOpts{})
+	if err != nil {
+		panic(err)
+	}
+
+Example to Authenticate with Client Credentials
+
+	authOpts := &oidc.AuthOptions{
+		AuthType:            oidc.AuthTypeClientCredentials,
+		IdentityProvider:    "sso",
+		Protocol:            "openid",
+		AccessTokenEndpoint: "https://sso.example.com/token",
+		ClientID:            "ci-runner",
+		ClientSecret:        "secret",
+		Scope:               tokens.Scope{ProjectID: "a99e9b4e620e4db09a2dfb6e42a01e66"},
+	}
+
+	err = openstack.AuthenticateV3(provider, authOpts, gophercloud.EndpointOpts{})
+
+Example to Exchange an Access Token for an Unscoped Token
+
+	authOpts := &oidc.AuthOptions{
+		AuthType:         oidc.AuthTypeAccessToken,
+		IdentityProvider: "sso",
+		Protocol:         "openid",
+		AccessToken:      accessToken,
+	}
+
+	token, err := oidc.Create(identityClient, authOpts).ExtractToken()
+	if err != nil {
+		panic(err)
+	}
+*/
+package oidc
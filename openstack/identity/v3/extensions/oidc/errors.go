@@ -0,0 +1,37 @@
+package oidc
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// ErrTokenEndpointNotFound is the error when the discovery document of the
+// OpenID Connect provider doesn't give its token endpoint.
+type ErrTokenEndpointNotFound struct {
+	gophercloud.BaseError
+	DiscoveryEndpoint string
+}
+
+func (e ErrTokenEndpointNotFound) Error() string {
+	return fmt.Sprintf("No token endpoint found in the discovery document at %s", e.DiscoveryEndpoint)
+}
+
+// ErrAccessTokenNotFound is the error when the response of the token endpoint
+// of the OpenID Connect provider doesn't hold a token of the requested type.
+type ErrAccessTokenNotFound struct {
+	gophercloud.BaseError
+	AccessTokenType string
+}
+
+func (e ErrAccessTokenNotFound) Error() string {
+	return fmt.Sprintf("No %s found in the response of the token endpoint", e.AccessTokenType)
+}
+
+// ErrNotExchanged is the error when AuthOptions are used to build a request
+// to the tokens API, since they must be exchanged through Create.
+type ErrNotExchanged struct{ gophercloud.BaseError }
+
+func (e ErrNotExchanged) Error() string {
+	return "OpenID Connect authentication options must be used with oidc.Create or openstack.AuthenticateV3"
+}
@@ -0,0 +1,296 @@
+package oidc
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"net/url"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/tokens"
+)
+
+// AuthType is the way an access token is obtained from the OpenID Connect
+// provider. The values are the names of the matching keystoneauth plugins,
+// as found in clouds.yaml files.
+type AuthType string
+
+const (
+	// AuthTypePassword obtains an access token with the resource owner
+	// password credentials grant, using Username and Password.
+	AuthTypePassword AuthType = "v3oidcpassword"
+
+	// AuthTypeClientCredentials obtains an access token with the client
+	// credentials grant, using ClientID and ClientSecret.
+	AuthTypeClientCredentials AuthType = "v3oidcclientcredentials"
+
+	// AuthTypeAccessToken uses the given AccessToken.
+	AuthTypeAccessToken AuthType = "v3oidcaccesstoken"
+)
+
+// AuthOptions are the options to authenticate through an OpenID Connect
+// identity provider federated with Keystone. They implement
+// tokens.AuthMethod so that they can be passed to openstack.AuthenticateV3,
+// but can't be passed to tokens.Create.
+type AuthOptions struct {
+	// AuthType is the way the access token is obtained. Required.
+	AuthType AuthType
+
+	// IdentityProvider is the ID of the identity provider in Keystone.
+	// Required.
+	IdentityProvider string
+
+	// Protocol is the ID of the federation protocol of the identity provider
+	// in Keystone, usually "openid". Required.
+	Protocol string
+
+	// DiscoveryEndpoint is the URL of the OpenID Connect discovery document
+	// of the provider, ending with "/.well-known/openid-configuration". It is
+	// used to find the token endpoint if AccessTokenEndpoint isn't set.
+	DiscoveryEndpoint string
+
+	// AccessTokenEndpoint is the URL of the token endpoint of the provider.
+	AccessTokenEndpoint string
+
+	// ClientID and ClientSecret authenticate the client to the token
+	// endpoint. ClientID is required to obtain an access token.
+	ClientID     string
+	ClientSecret string
+
+	// Username and Password are the credentials of the user, for
+	// AuthTypePassword.
+	Username string
+	Password string
+
+	// OpenIDScope is the space-separated list of the OpenID Connect scopes
+	// requested. Defaults to "openid".
+	OpenIDScope string
+
+	// AccessTokenType is the field of the token endpoint response holding
+	// the token exchanged with Keystone, "access_token" or "id_token".
+	// Defaults to "access_token".
+	AccessTokenType string
+
+	// AccessToken is the token exchanged with Keystone, for
+	// AuthTypeAccessToken.
+	AccessToken string
+
+	// Scope is the scope the unscoped token obtained from Keystone is
+	// rescoped to. The token stays unscoped if Scope is empty.
+	Scope tokens.Scope
+
+	// AllowReauth allows the client to authenticate again when the token
+	// expires. A new access token is obtained then, except with
+	// AuthTypeAccessToken.
+	AllowReauth bool
+}
+
+// ToTokenV3CreateMap implements tokens.AuthOptionsBuilder. It always fails,
+// since the options must be exchanged through Create.
+func (opts *AuthOptions) ToTokenV3CreateMap(map[string]interface{}) (map[string]interface{}, error) {
+	return nil, ErrNotExchanged{}
+}
+
+// ToTokenV3ScopeMap builds the scope the token is rescoped to.
+func (opts *AuthOptions) ToTokenV3ScopeMap() (map[string]interface{}, error) {
+	return (&tokens.AuthOptions{Scope: opts.Scope}).ToTokenV3ScopeMap()
+}
+
+// CanReauth reports whether the client may authenticate again.
+func (opts *AuthOptions) CanReauth() bool {
+	return opts.AllowReauth
+}
+
+// CreateToken implements tokens.AuthMethod, see Create.
+func (opts *AuthOptions) CreateToken(client *gophercloud.ServiceClient) tokens.CreateResult {
+	return Create(client, opts)
+}
+
+// TokenCacheRequest implements tokens.AuthMethod. It identifies the identity
+// provider, the user or client, and the scope, along with the hashes of the
+// credentials.
+func (opts *AuthOptions) TokenCacheRequest(scope map[string]interface{}) map[string]interface{} {
+	identity := map[string]interface{}{
+		"auth_type":         opts.AuthType,
+		"identity_provider": opts.IdentityProvider,
+		"protocol":          opts.Protocol,
+		"client_id":         opts.ClientID,
+		"username":          opts.Username,
+	}
+	if opts.AuthType == AuthTypeAccessToken {
+		identity["access_token_sha256"] = sha256Hex(opts.AccessToken)
+	} else {
+		identity["token_endpoint"] = opts.AccessTokenEndpoint
+		identity["discovery_endpoint"] = opts.DiscoveryEndpoint
+		identity["client_secret_sha256"] = sha256Hex(opts.ClientSecret)
+		identity["password_sha256"] = sha256Hex(opts.Password)
+	}
+	return map[string]interface{}{
+		"auth": map[string]interface{}{
+			"identity": map[string]interface{}{"oidc": identity},
+			"scope":    scope,
+		},
+	}
+}
+
+// WithoutReauth implements tokens.AuthMethod.
+func (opts *AuthOptions) WithoutReauth() tokens.AuthOptionsBuilder {
+	o := *opts
+	o.AllowReauth = false
+	return &o
+}
+
+func sha256Hex(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+func (opts *AuthOptions) validate() error {
+	required := map[string]string{
+		"IdentityProvider": opts.IdentityProvider,
+		"Protocol":         opts.Protocol,
+	}
+	switch opts.AuthType {
+	case AuthTypePassword:
+		required["ClientID"] = opts.ClientID
+		required["Username"] = opts.Username
+		required["Password"] = opts.Password
+	case AuthTypeClientCredentials:
+		required["ClientID"] = opts.ClientID
+		required["ClientSecret"] = opts.ClientSecret
+	case AuthTypeAccessToken:
+		required["AccessToken"] = opts.AccessToken
+	default:
+		err := gophercloud.ErrInvalidInput{}
+		err.Argument = "AuthType"
+		err.Value = opts.AuthType
+		return err
+	}
+	if opts.AuthType != AuthTypeAccessToken && opts.AccessTokenEndpoint == "" {
+		required["DiscoveryEndpoint"] = opts.DiscoveryEndpoint
+	}
+
+	for _, argument := range []string{"IdentityProvider", "Protocol", "ClientID", "ClientSecret", "Username", "Password", "AccessToken", "DiscoveryEndpoint"} {
+		if value, ok := required[argument]; ok && value == "" {
+			err := gophercloud.ErrMissingInput{}
+			err.Argument = argument
+			return err
+		}
+	}
+	return nil
+}
+
+// Create authenticates through the OpenID Connect provider and returns the
+// resulting Keystone token, scoped according to opts.Scope.
+//
+// The Keystone token of c, if any, is sent neither to the OpenID Connect
+// provider nor to Keystone.
+func Create(c *gophercloud.ServiceClient, opts *AuthOptions) (r tokens.CreateResult) {
+	if err := opts.validate(); err != nil {
+		r.Err = err
+		return
+	}
+
+	// Use an anonymous copy of the client, in the same way as when
+	// reauthenticating.
+	anonymous := *c.ProviderClient
+	anonymous.SetThrowaway(true)
+	anonymous.ReauthFunc = nil
+	anonymous.SetToken("")
+	client := *c
+	client.ProviderClient = &anonymous
+
+	accessToken := opts.AccessToken
+	if opts.AuthType != AuthTypeAccessToken {
+		var err error
+		accessToken, err = opts.obtainAccessToken(&anonymous)
+		if err != nil {
+			r.Err = err
+			return
+		}
+	}
+
+	resp, err := client.Post(federationAuthURL(&client, opts.IdentityProvider, opts.Protocol), nil, &r.Body, &gophercloud.RequestOpts{
+		MoreHeaders: map[string]string{"Authorization": "Bearer " + accessToken},
+		OkCodes:     []int{200, 201},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	if r.Err != nil || opts.Scope == (tokens.Scope{}) {
+		return
+	}
+
+	unscopedID, err := r.ExtractTokenID()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	return tokens.Create(&client, &tokens.AuthOptions{
+		TokenID: unscopedID,
+		Scope:   opts.Scope,
+	})
+}
+
+// obtainAccessToken requests a token from the token endpoint of the OpenID
+// Connect provider.
+func (opts *AuthOptions) obtainAccessToken(client *gophercloud.ProviderClient) (string, error) {
+	endpoint := opts.AccessTokenEndpoint
+	if endpoint == "" {
+		var discovery struct {
+			TokenEndpoint string `json:"token_endpoint"`
+		}
+		_, err := client.Request("GET", opts.DiscoveryEndpoint, &gophercloud.RequestOpts{
+			JSONResponse: &discovery,
+			OkCodes:      []int{200},
+		})
+		if err != nil {
+			return "", err
+		}
+		if discovery.TokenEndpoint == "" {
+			return "", ErrTokenEndpointNotFound{DiscoveryEndpoint: opts.DiscoveryEndpoint}
+		}
+		endpoint = discovery.TokenEndpoint
+	}
+
+	scope := opts.OpenIDScope
+	if scope == "" {
+		scope = "openid"
+	}
+	form := url.Values{"scope": {scope}}
+	if opts.AuthType == AuthTypePassword {
+		form.Set("grant_type", "password")
+		form.Set("username", opts.Username)
+		form.Set("password", opts.Password)
+	} else {
+		form.Set("grant_type", "client_credentials")
+	}
+
+	// The client authenticates with HTTP Basic authentication, see section
+	// 2.3.1 of RFC 6749.
+	credentials := url.QueryEscape(opts.ClientID) + ":" + url.QueryEscape(opts.ClientSecret)
+
+	var body map[string]interface{}
+	_, err := client.Request("POST", endpoint, &gophercloud.RequestOpts{
+		RawBody:      strings.NewReader(form.Encode()),
+		JSONResponse: &body,
+		OkCodes:      []int{200},
+		MoreHeaders: map[string]string{
+			"Content-Type":  "application/x-www-form-urlencoded",
+			"Authorization": "Basic " + base64.StdEncoding.EncodeToString([]byte(credentials)),
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	tokenType := opts.AccessTokenType
+	if tokenType == "" {
+		tokenType = "access_token"
+	}
+	token, _ := body[tokenType].(string)
+	if token == "" {
+		return "", ErrAccessTokenNotFound{AccessTokenType: tokenType}
+	}
+	return token, nil
+}
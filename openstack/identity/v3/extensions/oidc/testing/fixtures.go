@@ -0,0 +1,136 @@
+package testing
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+const (
+	accessToken     = "eyJhbGciOiJSUzI1NiJ9.access"
+	idToken         = "eyJhbGciOiJSUzI1NiJ9.id"
+	unscopedTokenID = "5a8bc3d8b5ad4e81a4d3b0e1b0b1c1a2"
+	scopedTokenID   = "c6a2d0fb2d4e4b5f8d1e3a7c9b0a1f2e"
+)
+
+// UnscopedTokenOutput is a sample response to a federated authentication.
+const UnscopedTokenOutput = `
+{
+	"token": {
+		"methods": ["openid"],
+		"expires_at": "2030-06-03T02:19:49.000000Z",
+		"user": {
+			"domain": {"id": "Federated", "name": "Federated"},
+			"id": "0ca8f6a4b1f34f3ea8d8c2e4a1b3c5d7",
+			"name": "jdoe",
+			"OS-FEDERATION": {
+				"identity_provider": {"id": "sso"},
+				"protocol": {"id": "openid"},
+				"groups": [{"id": "f1e2d3c4b5a6978899aabbccddeeff00"}]
+			}
+		}
+	}
+}
+`
+
+// ScopedTokenOutput is a sample response to the rescoping of a federated
+// token.
+const ScopedTokenOutput = `
+{
+	"token": {
+		"methods": ["token", "openid"],
+		"expires_at": "2030-06-03T02:19:49.000000Z",
+		"project": {
+			"domain": {"id": "default", "name": "Default"},
+			"id": "a99e9b4e620e4db09a2dfb6e42a01e66",
+			"name": "demo"
+		},
+		"user": {
+			"domain": {"id": "Federated", "name": "Federated"},
+			"id": "0ca8f6a4b1f34f3ea8d8c2e4a1b3c5d7",
+			"name": "jdoe"
+		},
+		"catalog": []
+	}
+}
+`
+
+// HandleDiscoverySuccessfully serves the discovery document of the OpenID
+// Connect provider.
+func HandleDiscoverySuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/sso/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", "")
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"issuer": "%[1]ssso", "token_endpoint": "%[1]ssso/token"}`, th.Endpoint())
+	})
+}
+
+// HandleTokenEndpointSuccessfully serves the token endpoint of the OpenID
+// Connect provider, checking the grant type and form parameters.
+func HandleTokenEndpointSuccessfully(t *testing.T, grantType string, form map[string]string) {
+	th.Mux.HandleFunc("/sso/token", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "Content-Type", "application/x-www-form-urlencoded")
+		th.TestHeader(t, r, "Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte("openstack:s3cr3t")))
+		th.TestHeader(t, r, "X-Auth-Token", "")
+
+		th.AssertNoErr(t, r.ParseForm())
+		th.AssertEquals(t, grantType, r.PostForm.Get("grant_type"))
+		for k, v := range form {
+			th.AssertEquals(t, v, r.PostForm.Get(k))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token": "%s", "id_token": "%s", "token_type": "Bearer", "expires_in": 300}`, accessToken, idToken)
+	})
+}
+
+// HandleFederationAuthSuccessfully serves the federated authentication
+// endpoint, expecting the given bearer token.
+func HandleFederationAuthSuccessfully(t *testing.T, bearer string) {
+	th.Mux.HandleFunc("/OS-FEDERATION/identity_providers/sso/protocols/openid/auth", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "Authorization", "Bearer "+bearer)
+		th.TestHeader(t, r, "X-Auth-Token", "")
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Subject-Token", unscopedTokenID)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, UnscopedTokenOutput)
+	})
+}
+
+// HandleRescopeSuccessfully serves the rescoping of the unscoped token to the
+// demo project.
+func HandleRescopeSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", "")
+		th.TestJSONRequest(t, r, fmt.Sprintf(`
+			{
+				"auth": {
+					"identity": {
+						"methods": ["token"],
+						"token": {"id": "%s"}
+					},
+					"scope": {
+						"project": {
+							"name": "demo",
+							"domain": {"name": "Default"}
+						}
+					}
+				}
+			}
+		`, unscopedTokenID))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Subject-Token", scopedTokenID)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, ScopedTokenOutput)
+	})
+}
@@ -0,0 +1,158 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/extensions/oidc"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/tokens"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestCreatePassword(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDiscoverySuccessfully(t)
+	HandleTokenEndpointSuccessfully(t, "password", map[string]string{
+		"username": "jdoe",
+		"password": "password",
+		"scope":    "openid profile",
+	})
+	HandleFederationAuthSuccessfully(t, accessToken)
+	HandleRescopeSuccessfully(t)
+
+	opts := &oidc.AuthOptions{
+		AuthType:          oidc.AuthTypePassword,
+		IdentityProvider:  "sso",
+		Protocol:          "openid",
+		DiscoveryEndpoint: th.Endpoint() + "sso/.well-known/openid-configuration",
+		ClientID:          "openstack",
+		ClientSecret:      "s3cr3t",
+		Username:          "jdoe",
+		Password:          "password",
+		OpenIDScope:       "openid profile",
+		Scope: tokens.Scope{
+			ProjectName: "demo",
+			DomainName:  "Default",
+		},
+	}
+
+	// The token of the client must not be sent.
+	r := oidc.Create(client.ServiceClient(), opts)
+	th.AssertNoErr(t, r.Err)
+
+	tokenID, err := r.ExtractTokenID()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, scopedTokenID, tokenID)
+
+	project, err := r.ExtractProject()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "demo", project.Name)
+}
+
+func TestCreateClientCredentials(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleTokenEndpointSuccessfully(t, "client_credentials", map[string]string{
+		"scope":    "openid",
+		"username": "",
+	})
+	HandleFederationAuthSuccessfully(t, idToken)
+
+	opts := &oidc.AuthOptions{
+		AuthType:            oidc.AuthTypeClientCredentials,
+		IdentityProvider:    "sso",
+		Protocol:            "openid",
+		AccessTokenEndpoint: th.Endpoint() + "sso/token",
+		AccessTokenType:     "id_token",
+		ClientID:            "openstack",
+		ClientSecret:        "s3cr3t",
+	}
+
+	r := oidc.Create(client.ServiceClient(), opts)
+	tokenID, err := r.ExtractTokenID()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, unscopedTokenID, tokenID)
+
+	token, err := r.ExtractToken()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "2030-06-03T02:19:49Z", token.ExpiresAt.Format("2006-01-02T15:04:05Z07:00"))
+}
+
+func TestCreateAccessToken(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleFederationAuthSuccessfully(t, "given-token")
+
+	opts := &oidc.AuthOptions{
+		AuthType:         oidc.AuthTypeAccessToken,
+		IdentityProvider: "sso",
+		Protocol:         "openid",
+		AccessToken:      "given-token",
+	}
+
+	tokenID, err := oidc.Create(client.ServiceClient(), opts).ExtractTokenID()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, unscopedTokenID, tokenID)
+}
+
+func TestCreateMissingInput(t *testing.T) {
+	for argument, opts := range map[string]*oidc.AuthOptions{
+		"IdentityProvider": {AuthType: oidc.AuthTypeAccessToken, Protocol: "openid", AccessToken: "token"},
+		"AccessToken":      {AuthType: oidc.AuthTypeAccessToken, IdentityProvider: "sso", Protocol: "openid"},
+		"ClientSecret":     {AuthType: oidc.AuthTypeClientCredentials, IdentityProvider: "sso", Protocol: "openid", ClientID: "openstack"},
+		"DiscoveryEndpoint": {
+			AuthType: oidc.AuthTypePassword, IdentityProvider: "sso", Protocol: "openid",
+			ClientID: "openstack", Username: "jdoe", Password: "password",
+		},
+	} {
+		err := oidc.Create(client.ServiceClient(), opts).Err
+		e, ok := err.(gophercloud.ErrMissingInput)
+		if !ok {
+			t.Errorf("%s: expected ErrMissingInput, got %T: %v", argument, err, err)
+			continue
+		}
+		th.CheckEquals(t, argument, e.Argument)
+	}
+
+	_, err := oidc.Create(client.ServiceClient(), &oidc.AuthOptions{AuthType: "v3password"}).ExtractTokenID()
+	if _, ok := err.(gophercloud.ErrInvalidInput); !ok {
+		t.Errorf("expected ErrInvalidInput, got %T: %v", err, err)
+	}
+}
+
+func TestCreateNoAccessToken(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleTokenEndpointSuccessfully(t, "client_credentials", nil)
+
+	opts := &oidc.AuthOptions{
+		AuthType:            oidc.AuthTypeClientCredentials,
+		IdentityProvider:    "sso",
+		Protocol:            "openid",
+		AccessTokenEndpoint: th.Endpoint() + "sso/token",
+		AccessTokenType:     "refresh_token",
+		ClientID:            "openstack",
+		ClientSecret:        "s3cr3t",
+	}
+
+	err := oidc.Create(client.ServiceClient(), opts).Err
+	if _, ok := err.(oidc.ErrAccessTokenNotFound); !ok {
+		t.Fatalf("expected ErrAccessTokenNotFound, got %T: %v", err, err)
+	}
+}
+
+func TestTokensCreateFails(t *testing.T) {
+	opts := &oidc.AuthOptions{
+		AuthType:         oidc.AuthTypeAccessToken,
+		IdentityProvider: "sso",
+		Protocol:         "openid",
+		AccessToken:      "token",
+	}
+
+	err := tokens.Create(client.ServiceClient(), opts).Err
+	if _, ok := err.(oidc.ErrNotExchanged); !ok {
+		t.Fatalf("expected ErrNotExchanged, got %T: %v", err, err)
+	}
+}
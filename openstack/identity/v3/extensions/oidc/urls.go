@@ -0,0 +1,7 @@
+package oidc
+
+import "github.com/gophercloud/gophercloud"
+
+func federationAuthURL(c *gophercloud.ServiceClient, idp, protocol string) string {
+	return c.ServiceURL("OS-FEDERATION", "identity_providers", idp, "protocols", protocol, "auth")
+}
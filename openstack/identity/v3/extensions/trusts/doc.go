@@ -22,5 +22,68 @@ Example to Create a Token with Username, Password, and Trust ID
 	if err != nil {
 		panic(err)
 	}
+
+Example to Create a Trust
+
+	expiresAt := time.Now().Add(24 * time.Hour)
+	createOpts := trusts.CreateOpts{
+		TrustorUserID: "bd263c",
+		TrusteeUserID: "0ca8f6",
+		ProjectID:     "9b71012f5a4a4aef9193f1995fe159b2",
+		Roles:         []trusts.Role{{Name: "member"}},
+		ExpiresAt:     &expiresAt,
+		RemainingUses: 10,
+	}
+
+	trust, err := trusts.Create(identityClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to List the Trusts Delegated to a User
+
+	listOpts := trusts.ListOpts{
+		TrusteeUserID: "0ca8f6",
+	}
+
+	allPages, err := trusts.List(identityClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allTrusts, err := trusts.ExtractTrusts(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, trust := range allTrusts {
+		fmt.Printf("%+v\n", trust)
+	}
+
+Example to List the Roles Delegated by a Trust
+
+	allPages, err := trusts.ListRoles(identityClient, "987fe8").AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allRoles, err := trusts.ExtractRoles(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Check Whether a Role is Delegated by a Trust
+
+	err := trusts.CheckRole(identityClient, "987fe8", "c1648e").ExtractErr()
+	if _, ok := err.(gophercloud.ErrDefault404); ok {
+		// The role isn't delegated by the trust.
+	}
+
+Example to Delete a Trust
+
+	err := trusts.Delete(identityClient, "987fe8").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
 */
 package trusts
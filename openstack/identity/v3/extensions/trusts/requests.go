@@ -1,6 +1,12 @@
 package trusts
 
-import "github.com/gophercloud/gophercloud/openstack/identity/v3/tokens"
+import (
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/tokens"
+	"github.com/gophercloud/gophercloud/pagination"
+)
 
 // AuthOptsExt extends the base Identity v3 tokens AuthOpts with a TrustID.
 type AuthOptsExt struct {
@@ -37,3 +43,153 @@ func (opts AuthOptsExt) ToTokenV3ScopeMap() (map[string]interface{}, error) {
 func (opts AuthOptsExt) CanReauth() bool {
 	return opts.AuthOptionsBuilder.CanReauth()
 }
+
+// CreateOptsBuilder allows extensions to add additional parameters to
+// the Create request.
+type CreateOptsBuilder interface {
+	ToTrustCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts provides options used to create a new trust.
+type CreateOpts struct {
+	// TrustorUserID is the ID of the user delegating its roles. It must be
+	// the user of the token used to create the trust.
+	TrustorUserID string `json:"trustor_user_id" required:"true"`
+
+	// TrusteeUserID is the ID of the user the roles are delegated to.
+	TrusteeUserID string `json:"trustee_user_id" required:"true"`
+
+	// Impersonation makes the tokens obtained through the trust appear as
+	// the trustor's rather than the trustee's.
+	Impersonation bool `json:"impersonation"`
+
+	// ProjectID is the project the roles are delegated on. A trust without a
+	// project delegates no roles.
+	ProjectID string `json:"project_id,omitempty"`
+
+	// Roles are the roles of the trustor on the project which are delegated,
+	// by ID or by name.
+	Roles []Role `json:"roles,omitempty"`
+
+	// ExpiresAt is the time at which the trust expires. The trust doesn't
+	// expire if it isn't set.
+	ExpiresAt *time.Time `json:"-"`
+
+	// RemainingUses is the number of times the trust can be used to obtain a
+	// token. The trust can be used any number of times if it isn't set.
+	RemainingUses int `json:"remaining_uses,omitempty"`
+
+	// AllowRedelegation allows the trustee to create trusts delegating the
+	// roles of this one in turn.
+	AllowRedelegation bool `json:"allow_redelegation,omitempty"`
+
+	// RedelegationCount is the maximum depth of the chain of trusts created
+	// through redelegation. It defaults to the maximum allowed by the cloud.
+	RedelegationCount int `json:"redelegation_count,omitempty"`
+}
+
+// ToTrustCreateMap formats a CreateOpts into a create request.
+func (opts CreateOpts) ToTrustCreateMap() (map[string]interface{}, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "trust")
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.ExpiresAt != nil {
+		if v, ok := b["trust"].(map[string]interface{}); ok {
+			v["expires_at"] = opts.ExpiresAt.UTC().Format(gophercloud.RFC3339Milli)
+		}
+	}
+
+	return b, nil
+}
+
+// Create creates a new trust.
+func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToTrustCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(rootURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to
+// the List request.
+type ListOptsBuilder interface {
+	ToTrustListQuery() (string, error)
+}
+
+// ListOpts provides options to filter the List results. Unless the token
+// allows listing every trust, one of them must be the user of the token.
+type ListOpts struct {
+	// TrustorUserID filters the response by the user delegating its roles.
+	TrustorUserID string `q:"trustor_user_id"`
+
+	// TrusteeUserID filters the response by the user the roles are
+	// delegated to.
+	TrusteeUserID string `q:"trustee_user_id"`
+}
+
+// ToTrustListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToTrustListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List enumerates the trusts.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(client)
+	if opts != nil {
+		query, err := opts.ToTrustListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return TrustPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves details on a single trust, by ID.
+func Get(client *gophercloud.ServiceClient, trustID string) (r GetResult) {
+	resp, err := client.Get(resourceURL(client, trustID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete deletes a trust. Only the trustor can delete it.
+func Delete(client *gophercloud.ServiceClient, trustID string) (r DeleteResult) {
+	resp, err := client.Delete(resourceURL(client, trustID), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ListRoles enumerates the roles delegated by a trust.
+func ListRoles(client *gophercloud.ServiceClient, trustID string) pagination.Pager {
+	return pagination.NewPager(client, listRolesURL(client, trustID), func(r pagination.PageResult) pagination.Page {
+		return RolesPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// GetRole retrieves details on a role delegated by a trust.
+func GetRole(client *gophercloud.ServiceClient, trustID, roleID string) (r GetRoleResult) {
+	resp, err := client.Get(roleURL(client, trustID, roleID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// CheckRole checks whether a role is delegated by a trust. The request
+// fails with a 404 error if it isn't.
+func CheckRole(client *gophercloud.ServiceClient, trustID, roleID string) (r CheckRoleResult) {
+	resp, err := client.Head(roleURL(client, trustID, roleID), &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
@@ -4,9 +4,12 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/extensions/trusts"
 	"github.com/gophercloud/gophercloud/openstack/identity/v3/tokens"
 	"github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
 )
 
 // HandleCreateTokenWithTrustID verifies that providing certain AuthOptions and Scope results in an expected JSON structure.
@@ -65,3 +68,259 @@ func HandleCreateTokenWithTrustID(t *testing.T, options tokens.AuthOptionsBuilde
 }`)
 	})
 }
+
+// CreateRequest is a sample request to create a trust.
+const CreateRequest = `
+{
+    "trust": {
+        "trustor_user_id": "bd263c",
+        "trustee_user_id": "0ca8f6",
+        "impersonation": false,
+        "project_id": "9b71012f5a4a4aef9193f1995fe159b2",
+        "roles": [
+            {
+                "name": "member"
+            }
+        ],
+        "expires_at": "2030-02-27T18:30:59.999999Z",
+        "remaining_uses": 10,
+        "allow_redelegation": true,
+        "redelegation_count": 2
+    }
+}
+`
+
+// GetResponse is a sample response to a Get or Create request.
+const GetResponse = `
+{
+    "trust": {
+        "id": "987fe8",
+        "impersonation": false,
+        "trustor_user_id": "bd263c",
+        "trustee_user_id": "0ca8f6",
+        "project_id": "9b71012f5a4a4aef9193f1995fe159b2",
+        "roles": [
+            {
+                "id": "c1648e",
+                "name": "member",
+                "links": {
+                    "self": "http://example.com/identity/v3/roles/c1648e"
+                }
+            }
+        ],
+        "roles_links": {
+            "next": null,
+            "previous": null,
+            "self": "http://example.com/identity/v3/OS-TRUST/trusts/987fe8/roles"
+        },
+        "expires_at": "2030-02-27T18:30:59.999999Z",
+        "remaining_uses": 10,
+        "allow_redelegation": true,
+        "redelegation_count": 2,
+        "redelegated_trust_id": null,
+        "links": {
+            "self": "http://example.com/identity/v3/OS-TRUST/trusts/987fe8"
+        }
+    }
+}
+`
+
+// ListResponse is a sample response to a List request.
+const ListResponse = `
+{
+    "links": {
+        "next": null,
+        "previous": null,
+        "self": "http://example.com/identity/v3/OS-TRUST/trusts"
+    },
+    "trusts": [
+        {
+            "id": "987fe8",
+            "impersonation": false,
+            "trustor_user_id": "bd263c",
+            "trustee_user_id": "0ca8f6",
+            "project_id": "9b71012f5a4a4aef9193f1995fe159b2",
+            "expires_at": "2030-02-27T18:30:59.999999Z",
+            "remaining_uses": 10,
+            "allow_redelegation": true,
+            "redelegation_count": 2,
+            "links": {
+                "self": "http://example.com/identity/v3/OS-TRUST/trusts/987fe8"
+            }
+        },
+        {
+            "id": "1ff900",
+            "impersonation": true,
+            "trustor_user_id": "bd263c",
+            "trustee_user_id": "0ca8f6",
+            "project_id": "9b71012f5a4a4aef9193f1995fe159b2",
+            "expires_at": null,
+            "remaining_uses": null,
+            "links": {
+                "self": "http://example.com/identity/v3/OS-TRUST/trusts/1ff900"
+            }
+        }
+    ]
+}
+`
+
+// ListRolesResponse is a sample response to a ListRoles request.
+const ListRolesResponse = `
+{
+    "links": {
+        "next": null,
+        "previous": null,
+        "self": "http://example.com/identity/v3/OS-TRUST/trusts/987fe8/roles"
+    },
+    "roles": [
+        {
+            "id": "c1648e",
+            "name": "member",
+            "links": {
+                "self": "http://example.com/identity/v3/roles/c1648e"
+            }
+        },
+        {
+            "id": "31d8a2",
+            "name": "reader",
+            "links": {
+                "self": "http://example.com/identity/v3/roles/31d8a2"
+            }
+        }
+    ]
+}
+`
+
+// GetRoleResponse is a sample response to a GetRole request.
+const GetRoleResponse = `
+{
+    "role": {
+        "id": "c1648e",
+        "name": "member",
+        "links": {
+            "self": "http://example.com/identity/v3/roles/c1648e"
+        }
+    }
+}
+`
+
+var expiresAt = time.Date(2030, 2, 27, 18, 30, 59, 999999000, time.UTC)
+
+// FirstTrust is the first trust in the List request.
+var FirstTrust = trusts.Trust{
+	ID:                "987fe8",
+	TrustorUserID:     "bd263c",
+	TrusteeUserID:     "0ca8f6",
+	ProjectID:         "9b71012f5a4a4aef9193f1995fe159b2",
+	ExpiresAt:         expiresAt,
+	RemainingUses:     10,
+	AllowRedelegation: true,
+	RedelegationCount: 2,
+}
+
+// SecondTrust is the second trust in the List request.
+var SecondTrust = trusts.Trust{
+	ID:            "1ff900",
+	Impersonation: true,
+	TrustorUserID: "bd263c",
+	TrusteeUserID: "0ca8f6",
+	ProjectID:     "9b71012f5a4a4aef9193f1995fe159b2",
+}
+
+// ExpectedTrustsSlice is the slice of trusts expected to be returned from
+// ListResponse.
+var ExpectedTrustsSlice = []trusts.Trust{FirstTrust, SecondTrust}
+
+// MemberRole is the role delegated by FirstTrust.
+var MemberRole = trusts.Role{
+	ID:   "c1648e",
+	Name: "member",
+}
+
+// ExpectedRolesSlice is the slice of roles expected to be returned from
+// ListRolesResponse.
+var ExpectedRolesSlice = []trusts.Role{
+	MemberRole,
+	{ID: "31d8a2", Name: "reader"},
+}
+
+// HandleCreateTrustSuccessfully creates an HTTP handler at `/OS-TRUST/trusts`
+// on the test handler mux that tests trust creation.
+func HandleCreateTrustSuccessfully(t *testing.T) {
+	testhelper.Mux.HandleFunc("/OS-TRUST/trusts", func(w http.ResponseWriter, r *http.Request) {
+		testhelper.TestMethod(t, r, "POST")
+		testhelper.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		testhelper.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, GetResponse)
+	})
+}
+
+// HandleListTrustsSuccessfully creates an HTTP handler at `/OS-TRUST/trusts`
+// on the test handler mux that responds with a list of two trusts.
+func HandleListTrustsSuccessfully(t *testing.T) {
+	testhelper.Mux.HandleFunc("/OS-TRUST/trusts", func(w http.ResponseWriter, r *http.Request) {
+		testhelper.TestMethod(t, r, "GET")
+		testhelper.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		testhelper.TestFormValues(t, r, map[string]string{"trustor_user_id": "bd263c"})
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListResponse)
+	})
+}
+
+// HandleGetTrustSuccessfully creates an HTTP handler at
+// `/OS-TRUST/trusts/987fe8` on the test handler mux that responds with a
+// single trust, or deletes it.
+func HandleGetTrustSuccessfully(t *testing.T) {
+	testhelper.Mux.HandleFunc("/OS-TRUST/trusts/987fe8", func(w http.ResponseWriter, r *http.Request) {
+		testhelper.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		switch r.Method {
+		case "GET":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, GetResponse)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+}
+
+// HandleTrustRolesSuccessfully creates HTTP handlers for the roles of trust
+// 987fe8 on the test handler mux. Only the member role is delegated.
+func HandleTrustRolesSuccessfully(t *testing.T) {
+	testhelper.Mux.HandleFunc("/OS-TRUST/trusts/987fe8/roles", func(w http.ResponseWriter, r *http.Request) {
+		testhelper.TestMethod(t, r, "GET")
+		testhelper.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListRolesResponse)
+	})
+
+	testhelper.Mux.HandleFunc("/OS-TRUST/trusts/987fe8/roles/", func(w http.ResponseWriter, r *http.Request) {
+		testhelper.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		if r.URL.Path != "/OS-TRUST/trusts/987fe8/roles/c1648e" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		switch r.Method {
+		case "GET":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, GetRoleResponse)
+		case "HEAD":
+			w.WriteHeader(http.StatusOK)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+}
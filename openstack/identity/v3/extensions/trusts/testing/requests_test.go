@@ -4,8 +4,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/identity/v3/extensions/trusts"
 	"github.com/gophercloud/gophercloud/openstack/identity/v3/tokens"
+	"github.com/gophercloud/gophercloud/pagination"
 	th "github.com/gophercloud/gophercloud/testhelper"
 	"github.com/gophercloud/gophercloud/testhelper/client"
 )
@@ -72,3 +74,118 @@ func TestCreateUserIDPasswordTrustID(t *testing.T) {
 
 	th.AssertDeepEquals(t, expected, actual)
 }
+
+func TestCreateTrust(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateTrustSuccessfully(t)
+
+	expiresAt := time.Date(2030, 2, 27, 19, 30, 59, 999999000, time.FixedZone("CET", 3600))
+	createOpts := trusts.CreateOpts{
+		TrustorUserID:     "bd263c",
+		TrusteeUserID:     "0ca8f6",
+		ProjectID:         "9b71012f5a4a4aef9193f1995fe159b2",
+		Roles:             []trusts.Role{{Name: "member"}},
+		ExpiresAt:         &expiresAt,
+		RemainingUses:     10,
+		AllowRedelegation: true,
+		RedelegationCount: 2,
+	}
+
+	expected := FirstTrust
+	expected.Roles = []trusts.Role{MemberRole}
+
+	actual, err := trusts.Create(client.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &expected, actual)
+}
+
+func TestCreateTrustMissingTrustee(t *testing.T) {
+	createOpts := trusts.CreateOpts{
+		TrustorUserID: "bd263c",
+	}
+
+	_, err := trusts.Create(client.ServiceClient(), createOpts).Extract()
+	if _, ok := err.(gophercloud.ErrMissingInput); !ok {
+		t.Fatalf("Expected ErrMissingInput, got %T: %v", err, err)
+	}
+}
+
+func TestListTrusts(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListTrustsSuccessfully(t)
+
+	count := 0
+	err := trusts.List(client.ServiceClient(), trusts.ListOpts{TrustorUserID: "bd263c"}).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+
+		actual, err := trusts.ExtractTrusts(page)
+		th.AssertNoErr(t, err)
+
+		th.CheckDeepEquals(t, ExpectedTrustsSlice, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, count, 1)
+}
+
+func TestGetTrust(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetTrustSuccessfully(t)
+
+	expected := FirstTrust
+	expected.Roles = []trusts.Role{MemberRole}
+
+	actual, err := trusts.Get(client.ServiceClient(), "987fe8").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &expected, actual)
+}
+
+func TestDeleteTrust(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetTrustSuccessfully(t)
+
+	err := trusts.Delete(client.ServiceClient(), "987fe8").ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestListTrustRoles(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleTrustRolesSuccessfully(t)
+
+	allPages, err := trusts.ListRoles(client.ServiceClient(), "987fe8").AllPages()
+	th.AssertNoErr(t, err)
+
+	actual, err := trusts.ExtractRoles(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedRolesSlice, actual)
+}
+
+func TestGetTrustRole(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleTrustRolesSuccessfully(t)
+
+	actual, err := trusts.GetRole(client.ServiceClient(), "987fe8", "c1648e").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &MemberRole, actual)
+}
+
+func TestCheckTrustRole(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleTrustRolesSuccessfully(t)
+
+	err := trusts.CheckRole(client.ServiceClient(), "987fe8", "c1648e").ExtractErr()
+	th.AssertNoErr(t, err)
+
+	err = trusts.CheckRole(client.ServiceClient(), "987fe8", "31d8a2").ExtractErr()
+	if _, ok := err.(gophercloud.ErrDefault404); !ok {
+		t.Fatalf("Expected ErrDefault404, got %T: %v", err, err)
+	}
+}
@@ -0,0 +1,21 @@
+package trusts
+
+import "github.com/gophercloud/gophercloud"
+
+const resourcePath = "OS-TRUST/trusts"
+
+func rootURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(resourcePath)
+}
+
+func resourceURL(c *gophercloud.ServiceClient, trustID string) string {
+	return c.ServiceURL(resourcePath, trustID)
+}
+
+func listRolesURL(c *gophercloud.ServiceClient, trustID string) string {
+	return c.ServiceURL(resourcePath, trustID, "roles")
+}
+
+func roleURL(c *gophercloud.ServiceClient, trustID, roleID string) string {
+	return c.ServiceURL(resourcePath, trustID, "roles", roleID)
+}
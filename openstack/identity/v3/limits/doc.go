@@ -0,0 +1,91 @@
+/*
+Package limits manages the limits of the OpenStack Identity service, the
+limits of the resources of the services for a project or a domain, which
+override their registered limits.
+
+Example to Get the Enforcement Model
+
+	model, err := limits.GetEnforcementModel(identityClient).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to List Limits
+
+	listOpts := limits.ListOpts{
+		ProjectID: "3a705b9f56bb439381b43c4fe59dccce",
+	}
+
+	allPages, err := limits.List(identityClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allLimits, err := limits.ExtractLimits(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, limit := range allLimits {
+		fmt.Printf("%s: %d\n", limit.ResourceName, limit.ResourceLimit)
+	}
+
+Example to Create Limits
+
+	createOpts := limits.BatchCreateOpts{
+		{
+			ServiceID:     "9408080f1970482aa0e38bc2d4ea34b7",
+			ProjectID:     "3a705b9f56bb439381b43c4fe59dccce",
+			ResourceName:  "cores",
+			ResourceLimit: 40,
+		},
+	}
+
+	createdLimits, err := limits.BatchCreate(identityClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update a Limit
+
+	resourceLimit := 80
+	updateOpts := limits.UpdateOpts{
+		ResourceLimit: &resourceLimit,
+	}
+
+	limit, err := limits.Update(identityClient, "25a04c7a065c430590881c646cdcdd58", updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Limit
+
+	err := limits.Delete(identityClient, "25a04c7a065c430590881c646cdcdd58").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Compute the Effective Limit of a Project
+
+	model, err := limits.GetEnforcementModel(identityClient).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	// allProjects, allRegisteredLimits and allLimits are listed with
+	// projects.List, registeredlimits.List and limits.List.
+	effectiveOpts := limits.EffectiveLimitOpts{
+		Model:            model.Name,
+		ServiceID:        "9408080f1970482aa0e38bc2d4ea34b7",
+		ResourceName:     "cores",
+		Projects:         allProjects,
+		RegisteredLimits: allRegisteredLimits,
+		Limits:           allLimits,
+	}
+
+	cores, err := limits.EffectiveLimit("3a705b9f56bb439381b43c4fe59dccce", effectiveOpts)
+	if err != nil {
+		panic(err)
+	}
+*/
+package limits
@@ -0,0 +1,124 @@
+package limits
+
+import (
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/projects"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/registeredlimits"
+)
+
+// EffectiveLimitOpts holds what EffectiveLimit computes a limit from. They are
+// usually the results of GetEnforcementModel, projects.List,
+// registeredlimits.List and List.
+type EffectiveLimitOpts struct {
+	// Model is the enforcement model, ModelFlat or ModelStrictTwoLevel.
+	Model string
+
+	// ServiceID, ResourceName and RegionID identify the resource. RegionID
+	// is empty for a resource limited in every region at once.
+	ServiceID    string
+	ResourceName string
+	RegionID     string
+
+	// Projects is the project hierarchy. It must contain the project and,
+	// under ModelStrictTwoLevel, its parent.
+	Projects []projects.Project
+
+	// RegisteredLimits are the default limits of the resources.
+	RegisteredLimits []registeredlimits.RegisteredLimit
+
+	// Limits are the limits of the projects, overriding the default ones.
+	Limits []Limit
+}
+
+/*
+EffectiveLimit computes, without a request to Keystone, the limit which
+applies to the usage of a resource by a project, the way oslo.limit enforces
+it:
+
+Under ModelFlat, it is the limit of the project for the resource, or the
+registered limit of the resource if the project has none. The limits of the
+other projects of the hierarchy don't matter.
+
+Under ModelStrictTwoLevel, the project is either a top-level project, whose
+limit is computed as under ModelFlat, or the child of one, whose limit can't
+exceed the limit of its parent. Note that the usage of the children of a
+project also counts against the limit of the project. EffectiveLimit returns
+an ErrHierarchyTooDeep for a project deeper in the hierarchy.
+
+Domain limits aren't taken into account.
+*/
+func EffectiveLimit(projectID string, opts EffectiveLimitOpts) (int, error) {
+	project, err := opts.findProject(projectID)
+	if err != nil {
+		return 0, err
+	}
+
+	switch opts.Model {
+	case ModelFlat:
+		return opts.projectLimit(project.ID)
+	case ModelStrictTwoLevel:
+	default:
+		return 0, ErrUnknownModel{Model: opts.Model}
+	}
+
+	if isTopLevel(project) {
+		return opts.projectLimit(project.ID)
+	}
+
+	parent, err := opts.findProject(project.ParentID)
+	if err != nil {
+		return 0, err
+	}
+	if !isTopLevel(parent) {
+		return 0, ErrHierarchyTooDeep{ProjectID: project.ID}
+	}
+
+	limit, err := opts.projectLimit(project.ID)
+	if err != nil {
+		return 0, err
+	}
+	parentLimit, err := opts.projectLimit(parent.ID)
+	if err != nil {
+		return 0, err
+	}
+	if parentLimit < limit {
+		return parentLimit, nil
+	}
+	return limit, nil
+}
+
+// isTopLevel reports whether the parent of a project is its domain.
+func isTopLevel(project *projects.Project) bool {
+	return project.ParentID == "" || project.ParentID == project.DomainID
+}
+
+func (opts EffectiveLimitOpts) findProject(projectID string) (*projects.Project, error) {
+	for i := range opts.Projects {
+		if opts.Projects[i].ID == projectID {
+			return &opts.Projects[i], nil
+		}
+	}
+	return nil, ErrProjectNotFound{ProjectID: projectID}
+}
+
+// projectLimit returns the limit of a project, ignoring its hierarchy.
+func (opts EffectiveLimitOpts) projectLimit(projectID string) (int, error) {
+	for _, l := range opts.Limits {
+		if l.ProjectID == projectID && l.ServiceID == opts.ServiceID &&
+			l.ResourceName == opts.ResourceName && l.RegionID == opts.RegionID {
+			return l.ResourceLimit, nil
+		}
+	}
+
+	for _, rl := range opts.RegisteredLimits {
+		if rl.ServiceID == opts.ServiceID && rl.ResourceName == opts.ResourceName &&
+			rl.RegionID == opts.RegionID {
+			return rl.DefaultLimit, nil
+		}
+	}
+
+	return 0, ErrRegisteredLimitNotFound{
+		ServiceID:    opts.ServiceID,
+		ResourceName: opts.ResourceName,
+		RegionID:     opts.RegionID,
+	}
+}
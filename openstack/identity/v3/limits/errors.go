@@ -0,0 +1,72 @@
+package limits
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// ErrUnknownModel is returned by EffectiveLimit when the enforcement model
+// isn't supported.
+type ErrUnknownModel struct {
+	gophercloud.BaseError
+	Model string
+}
+
+func (e ErrUnknownModel) Error() string {
+	e.DefaultErrString = fmt.Sprintf("Unknown limit enforcement model %q", e.Model)
+	return choseErrString(e.BaseError)
+}
+
+// ErrProjectNotFound is returned by EffectiveLimit when the project, or its
+// parent, isn't part of the given hierarchy.
+type ErrProjectNotFound struct {
+	gophercloud.BaseError
+	ProjectID string
+}
+
+func (e ErrProjectNotFound) Error() string {
+	e.DefaultErrString = fmt.Sprintf("Project %s is not part of the hierarchy", e.ProjectID)
+	return choseErrString(e.BaseError)
+}
+
+// ErrRegisteredLimitNotFound is returned by EffectiveLimit when the resource
+// has no registered limit, and the project no limit of its own.
+type ErrRegisteredLimitNotFound struct {
+	gophercloud.BaseError
+	ServiceID    string
+	ResourceName string
+	RegionID     string
+}
+
+func (e ErrRegisteredLimitNotFound) Error() string {
+	e.DefaultErrString = fmt.Sprintf(
+		"No registered limit for resource %s of service %s in region %q",
+		e.ResourceName, e.ServiceID, e.RegionID,
+	)
+	return choseErrString(e.BaseError)
+}
+
+// ErrHierarchyTooDeep is returned by EffectiveLimit under the
+// strict-two-level model when the project is more than two levels deep.
+type ErrHierarchyTooDeep struct {
+	gophercloud.BaseError
+	ProjectID string
+}
+
+func (e ErrHierarchyTooDeep) Error() string {
+	e.DefaultErrString = fmt.Sprintf(
+		"Project %s is more than two levels deep, which the %s model doesn't allow",
+		e.ProjectID, ModelStrictTwoLevel,
+	)
+	return choseErrString(e.BaseError)
+}
+
+// choseErrString returns the Info of e if it is set, or its
+// DefaultErrString, like the errors of the gophercloud package.
+func choseErrString(e gophercloud.BaseError) string {
+	if e.Info != "" {
+		return e.Info
+	}
+	return e.DefaultErrString
+}
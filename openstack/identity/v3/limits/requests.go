@@ -0,0 +1,169 @@
+package limits
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// GetEnforcementModel retrieves the enforcement model of the limits, which
+// determines how the limits of a project hierarchy interact.
+func GetEnforcementModel(client *gophercloud.ServiceClient) (r EnforcementModelResult) {
+	resp, err := client.Get(enforcementModelURL(client), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to
+// the List request
+type ListOptsBuilder interface {
+	ToLimitListQuery() (string, error)
+}
+
+// ListOpts provides options to filter the List results.
+type ListOpts struct {
+	// ServiceID filters the response by the service of the resource.
+	ServiceID string `q:"service_id"`
+
+	// ResourceName filters the response by a resource name.
+	ResourceName string `q:"resource_name"`
+
+	// RegionID filters the response by a region ID.
+	RegionID string `q:"region_id"`
+
+	// ProjectID filters the response by a project ID.
+	ProjectID string `q:"project_id"`
+
+	// DomainID filters the response by a domain ID.
+	DomainID string `q:"domain_id"`
+}
+
+// ToLimitListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToLimitListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List enumerates the limits.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(client)
+	if opts != nil {
+		query, err := opts.ToLimitListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return LimitPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// BatchCreateOptsBuilder allows extensions to add additional parameters to
+// the BatchCreate request.
+type BatchCreateOptsBuilder interface {
+	ToLimitsCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts provides options used to set the limit of a resource for a
+// project or a domain. The resource must have a registered limit.
+type CreateOpts struct {
+	// ServiceID is the ID of the service owning the resource.
+	ServiceID string `json:"service_id" required:"true"`
+
+	// ResourceName is the name of the resource.
+	ResourceName string `json:"resource_name" required:"true"`
+
+	// ResourceLimit is the limit of the project or domain.
+	ResourceLimit int `json:"resource_limit"`
+
+	// ProjectID is the project the limit applies to. Either ProjectID or
+	// DomainID is required.
+	ProjectID string `json:"project_id,omitempty"`
+
+	// DomainID is the domain the limit applies to.
+	DomainID string `json:"domain_id,omitempty"`
+
+	// RegionID is the region the limit applies to.
+	RegionID string `json:"region_id,omitempty"`
+
+	// Description is a description of the limit.
+	Description string `json:"description,omitempty"`
+}
+
+// BatchCreateOpts provides options used to set several limits at once.
+type BatchCreateOpts []CreateOpts
+
+// ToLimitsCreateMap formats a BatchCreateOpts into a create request.
+func (opts BatchCreateOpts) ToLimitsCreateMap() (map[string]interface{}, error) {
+	limits := make([]map[string]interface{}, len(opts))
+	for i, limit := range opts {
+		b, err := gophercloud.BuildRequestBody(limit, "")
+		if err != nil {
+			return nil, err
+		}
+		limits[i] = b
+	}
+	return map[string]interface{}{"limits": limits}, nil
+}
+
+// BatchCreate sets several limits.
+func BatchCreate(client *gophercloud.ServiceClient, opts BatchCreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToLimitsCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(rootURL(client), &b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Get retrieves details on a single limit, by ID.
+func Get(client *gophercloud.ServiceClient, limitID string) (r GetResult) {
+	resp, err := client.Get(resourceURL(client, limitID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to
+// the Update request.
+type UpdateOptsBuilder interface {
+	ToLimitUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts provides options for updating a limit.
+type UpdateOpts struct {
+	// ResourceLimit is the limit of the project or domain.
+	ResourceLimit *int `json:"resource_limit,omitempty"`
+
+	// Description is a description of the limit.
+	Description *string `json:"description,omitempty"`
+}
+
+// ToLimitUpdateMap formats an UpdateOpts into an update request.
+func (opts UpdateOpts) ToLimitUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "limit")
+}
+
+// Update updates an existing limit.
+func Update(client *gophercloud.ServiceClient, limitID string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToLimitUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Patch(resourceURL(client, limitID), &b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete deletes a limit.
+func Delete(client *gophercloud.ServiceClient, limitID string) (r DeleteResult) {
+	resp, err := client.Delete(resourceURL(client, limitID), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
@@ -0,0 +1,156 @@
+package limits
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+const (
+	// ModelFlat is the enforcement model where the limit of each project
+	// only applies to its own usage.
+	ModelFlat = "flat"
+
+	// ModelStrictTwoLevel is the enforcement model where projects have at
+	// most one level of children, the limit of a child can't exceed the
+	// limit of its parent, and the limit of a parent applies to the usage of
+	// the parent and its children together.
+	ModelStrictTwoLevel = "strict-two-level"
+)
+
+// EnforcementModel is the enforcement model of the limits.
+type EnforcementModel struct {
+	// Name is the name of the model, e.g. ModelFlat.
+	Name string `json:"name"`
+
+	// Description is a description of the model.
+	Description string `json:"description"`
+}
+
+// EnforcementModelResult is the response from a GetEnforcementModel
+// operation. Call its Extract method to interpret it as an EnforcementModel.
+type EnforcementModelResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets an EnforcementModelResult as an EnforcementModel.
+func (r EnforcementModelResult) Extract() (*EnforcementModel, error) {
+	var s struct {
+		Model *EnforcementModel `json:"model"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Model, err
+}
+
+// Limit is the limit of a resource for a project or a domain, overriding the
+// registered limit of the resource.
+type Limit struct {
+	// ID is the unique ID of the limit.
+	ID string `json:"id"`
+
+	// ServiceID is the ID of the service owning the resource.
+	ServiceID string `json:"service_id"`
+
+	// ResourceName is the name of the resource.
+	ResourceName string `json:"resource_name"`
+
+	// ResourceLimit is the limit of the project or domain.
+	ResourceLimit int `json:"resource_limit"`
+
+	// ProjectID is the project the limit applies to.
+	ProjectID string `json:"project_id"`
+
+	// DomainID is the domain the limit applies to.
+	DomainID string `json:"domain_id"`
+
+	// RegionID is the region the limit applies to. It is empty if it
+	// applies to every region.
+	RegionID string `json:"region_id"`
+
+	// Description is a description of the limit.
+	Description string `json:"description"`
+
+	// Links contains referencing links to the limit.
+	Links map[string]interface{} `json:"links"`
+}
+
+type limitResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets any limit result as a Limit.
+func (r limitResult) Extract() (*Limit, error) {
+	var s struct {
+		Limit *Limit `json:"limit"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Limit, err
+}
+
+// CreateResult is the response from a BatchCreate operation. Call its Extract
+// method to interpret it as a slice of Limits.
+type CreateResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a CreateResult as the created Limits.
+func (r CreateResult) Extract() ([]Limit, error) {
+	var s struct {
+		Limits []Limit `json:"limits"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Limits, err
+}
+
+// GetResult is the response from a Get operation. Call its Extract method
+// to interpret it as a Limit.
+type GetResult struct {
+	limitResult
+}
+
+// UpdateResult is the response from an Update operation. Call its Extract
+// method to interpret it as a Limit.
+type UpdateResult struct {
+	limitResult
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr to
+// determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// LimitPage is a single page of Limit results.
+type LimitPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty determines whether or not a LimitPage contains any results.
+func (r LimitPage) IsEmpty() (bool, error) {
+	limits, err := ExtractLimits(r)
+	return len(limits) == 0, err
+}
+
+// NextPageURL extracts the "next" link from the links section of the result.
+func (r LimitPage) NextPageURL() (string, error) {
+	var s struct {
+		Links struct {
+			Next     string `json:"next"`
+			Previous string `json:"previous"`
+		} `json:"links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return s.Links.Next, err
+}
+
+// ExtractLimits returns a slice of Limits contained in a single page of
+// results.
+func ExtractLimits(r pagination.Page) ([]Limit, error) {
+	var s struct {
+		Limits []Limit `json:"limits"`
+	}
+	err := (r.(LimitPage)).ExtractInto(&s)
+	return s.Limits, err
+}
@@ -0,0 +1,115 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/limits"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/projects"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/registeredlimits"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+// effectiveLimitOpts returns options for the cores of a hierarchy where
+// project "parent" is in domain "default" and has a child, "child", which
+// itself has a child, "grandchild".
+func effectiveLimitOpts(model string) limits.EffectiveLimitOpts {
+	return limits.EffectiveLimitOpts{
+		Model:        model,
+		ServiceID:    "compute",
+		ResourceName: "cores",
+		Projects: []projects.Project{
+			{ID: "parent", DomainID: "default", ParentID: "default"},
+			{ID: "child", DomainID: "default", ParentID: "parent"},
+			{ID: "grandchild", DomainID: "default", ParentID: "child"},
+			{ID: "other", DomainID: "default", ParentID: "default"},
+		},
+		RegisteredLimits: []registeredlimits.RegisteredLimit{
+			{ServiceID: "compute", ResourceName: "cores", DefaultLimit: 10},
+			{ServiceID: "compute", ResourceName: "cores", RegionID: "RegionOne", DefaultLimit: 5},
+			{ServiceID: "compute", ResourceName: "ram_mb", DefaultLimit: 1024},
+		},
+		Limits: []limits.Limit{
+			{ProjectID: "parent", ServiceID: "compute", ResourceName: "cores", ResourceLimit: 20},
+			{ProjectID: "child", ServiceID: "compute", ResourceName: "cores", ResourceLimit: 30},
+			{ProjectID: "other", ServiceID: "compute", ResourceName: "ram_mb", ResourceLimit: 2048},
+		},
+	}
+}
+
+func TestEffectiveLimitFlat(t *testing.T) {
+	opts := effectiveLimitOpts(limits.ModelFlat)
+
+	for projectID, expected := range map[string]int{
+		"parent":     20,
+		"child":      30,
+		"grandchild": 10,
+		"other":      10,
+	} {
+		actual, err := limits.EffectiveLimit(projectID, opts)
+		th.AssertNoErr(t, err)
+		th.CheckEquals(t, expected, actual)
+	}
+
+	opts.RegionID = "RegionOne"
+	actual, err := limits.EffectiveLimit("parent", opts)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 5, actual)
+}
+
+func TestEffectiveLimitStrictTwoLevel(t *testing.T) {
+	opts := effectiveLimitOpts(limits.ModelStrictTwoLevel)
+
+	for projectID, expected := range map[string]int{
+		"parent": 20,
+		"child":  20,
+		"other":  10,
+	} {
+		actual, err := limits.EffectiveLimit(projectID, opts)
+		th.AssertNoErr(t, err)
+		th.CheckEquals(t, expected, actual)
+	}
+
+	opts.Limits[1].ResourceLimit = 15
+	actual, err := limits.EffectiveLimit("child", opts)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 15, actual)
+}
+
+func TestEffectiveLimitStrictTwoLevelTooDeep(t *testing.T) {
+	_, err := limits.EffectiveLimit("grandchild", effectiveLimitOpts(limits.ModelStrictTwoLevel))
+	if _, ok := err.(limits.ErrHierarchyTooDeep); !ok {
+		t.Fatalf("Expected ErrHierarchyTooDeep, got %T: %v", err, err)
+	}
+}
+
+func TestEffectiveLimitErrors(t *testing.T) {
+	opts := effectiveLimitOpts(limits.ModelFlat)
+
+	_, err := limits.EffectiveLimit("unknown", opts)
+	if _, ok := err.(limits.ErrProjectNotFound); !ok {
+		t.Fatalf("Expected ErrProjectNotFound, got %T: %v", err, err)
+	}
+
+	opts.ResourceName = "instances"
+	_, err = limits.EffectiveLimit("parent", opts)
+	if _, ok := err.(limits.ErrRegisteredLimitNotFound); !ok {
+		t.Fatalf("Expected ErrRegisteredLimitNotFound, got %T: %v", err, err)
+	}
+
+	opts.Model = "nested"
+	_, err = limits.EffectiveLimit("parent", opts)
+	e, ok := err.(limits.ErrUnknownModel)
+	if !ok {
+		t.Fatalf("Expected ErrUnknownModel, got %T: %v", err, err)
+	}
+	th.CheckEquals(t, `Unknown limit enforcement model "nested"`, e.Error())
+	e.Info = "custom message"
+	th.CheckEquals(t, "custom message", e.Error())
+
+	opts = effectiveLimitOpts(limits.ModelStrictTwoLevel)
+	opts.Projects = opts.Projects[1:]
+	_, err = limits.EffectiveLimit("child", opts)
+	if _, ok := err.(limits.ErrProjectNotFound); !ok {
+		t.Fatalf("Expected ErrProjectNotFound, got %T: %v", err, err)
+	}
+}
@@ -0,0 +1,250 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/limits"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// GetEnforcementModelOutput provides a GetEnforcementModel result.
+const GetEnforcementModelOutput = `
+{
+    "model": {
+        "name": "flat",
+        "description": "Limit enforcement and validation does not take project hierarchy into consideration."
+    }
+}
+`
+
+// ListOutput provides a single page of Limit results.
+const ListOutput = `
+{
+    "links": {
+        "self": "http://example.com/identity/v3/limits",
+        "previous": null,
+        "next": null
+    },
+    "limits": [
+        {
+            "id": "25a04c7a065c430590881c646cdcdd58",
+            "service_id": "9408080f1970482aa0e38bc2d4ea34b7",
+            "project_id": "3a705b9f56bb439381b43c4fe59dccce",
+            "domain_id": null,
+            "region_id": null,
+            "resource_name": "cores",
+            "resource_limit": 40,
+            "description": "Number of cores for project 3a705b",
+            "links": {
+                "self": "http://example.com/identity/v3/limits/25a04c7a065c430590881c646cdcdd58"
+            }
+        },
+        {
+            "id": "3229b3849f584faea483d6851f7aab05",
+            "service_id": "9408080f1970482aa0e38bc2d4ea34b7",
+            "project_id": "3a705b9f56bb439381b43c4fe59dccce",
+            "domain_id": null,
+            "region_id": "RegionOne",
+            "resource_name": "ram_mb",
+            "resource_limit": 102400,
+            "description": null,
+            "links": {
+                "self": "http://example.com/identity/v3/limits/3229b3849f584faea483d6851f7aab05"
+            }
+        }
+    ]
+}
+`
+
+// GetOutput provides a Get result.
+const GetOutput = `
+{
+    "limit": {
+        "id": "25a04c7a065c430590881c646cdcdd58",
+        "service_id": "9408080f1970482aa0e38bc2d4ea34b7",
+        "project_id": "3a705b9f56bb439381b43c4fe59dccce",
+        "domain_id": null,
+        "region_id": null,
+        "resource_name": "cores",
+        "resource_limit": 40,
+        "description": "Number of cores for project 3a705b",
+        "links": {
+            "self": "http://example.com/identity/v3/limits/25a04c7a065c430590881c646cdcdd58"
+        }
+    }
+}
+`
+
+// CreateRequest provides the input to a BatchCreate request.
+const CreateRequest = `
+{
+    "limits": [
+        {
+            "service_id": "9408080f1970482aa0e38bc2d4ea34b7",
+            "project_id": "3a705b9f56bb439381b43c4fe59dccce",
+            "resource_name": "cores",
+            "resource_limit": 40,
+            "description": "Number of cores for project 3a705b"
+        },
+        {
+            "service_id": "9408080f1970482aa0e38bc2d4ea34b7",
+            "project_id": "3a705b9f56bb439381b43c4fe59dccce",
+            "region_id": "RegionOne",
+            "resource_name": "ram_mb",
+            "resource_limit": 102400
+        }
+    ]
+}
+`
+
+// UpdateRequest provides the input to an Update request.
+const UpdateRequest = `
+{
+    "limit": {
+        "resource_limit": 80
+    }
+}
+`
+
+// UpdateOutput provides an Update result.
+const UpdateOutput = `
+{
+    "limit": {
+        "id": "25a04c7a065c430590881c646cdcdd58",
+        "service_id": "9408080f1970482aa0e38bc2d4ea34b7",
+        "project_id": "3a705b9f56bb439381b43c4fe59dccce",
+        "domain_id": null,
+        "region_id": null,
+        "resource_name": "cores",
+        "resource_limit": 80,
+        "description": "Number of cores for project 3a705b",
+        "links": {
+            "self": "http://example.com/identity/v3/limits/25a04c7a065c430590881c646cdcdd58"
+        }
+    }
+}
+`
+
+// FlatModel is the enforcement model in GetEnforcementModelOutput.
+var FlatModel = limits.EnforcementModel{
+	Name:        "flat",
+	Description: "Limit enforcement and validation does not take project hierarchy into consideration.",
+}
+
+// FirstLimit is the first limit in the List request.
+var FirstLimit = limits.Limit{
+	ID:            "25a04c7a065c430590881c646cdcdd58",
+	ServiceID:     "9408080f1970482aa0e38bc2d4ea34b7",
+	ProjectID:     "3a705b9f56bb439381b43c4fe59dccce",
+	ResourceName:  "cores",
+	ResourceLimit: 40,
+	Description:   "Number of cores for project 3a705b",
+	Links: map[string]interface{}{
+		"self": "http://example.com/identity/v3/limits/25a04c7a065c430590881c646cdcdd58",
+	},
+}
+
+// SecondLimit is the second limit in the List request.
+var SecondLimit = limits.Limit{
+	ID:            "3229b3849f584faea483d6851f7aab05",
+	ServiceID:     "9408080f1970482aa0e38bc2d4ea34b7",
+	ProjectID:     "3a705b9f56bb439381b43c4fe59dccce",
+	RegionID:      "RegionOne",
+	ResourceName:  "ram_mb",
+	ResourceLimit: 102400,
+	Links: map[string]interface{}{
+		"self": "http://example.com/identity/v3/limits/3229b3849f584faea483d6851f7aab05",
+	},
+}
+
+// ExpectedLimitsSlice is the slice of limits expected to be returned from
+// ListOutput.
+var ExpectedLimitsSlice = []limits.Limit{FirstLimit, SecondLimit}
+
+// HandleGetEnforcementModelSuccessfully creates an HTTP handler at
+// `/limits/model` on the test handler mux that responds with the flat model.
+func HandleGetEnforcementModelSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/limits/model", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, GetEnforcementModelOutput)
+	})
+}
+
+// HandleListLimitsSuccessfully creates an HTTP handler at `/limits` on the
+// test handler mux that responds with a list of two limits.
+func HandleListLimitsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/limits", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"project_id": "3a705b9f56bb439381b43c4fe59dccce"})
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListOutput)
+	})
+}
+
+// HandleBatchCreateLimitsSuccessfully creates an HTTP handler at `/limits` on
+// the test handler mux that tests limit creation.
+func HandleBatchCreateLimitsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/limits", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, ListOutput)
+	})
+}
+
+// HandleGetLimitSuccessfully creates an HTTP handler at
+// `/limits/25a04c7a065c430590881c646cdcdd58` on the test handler mux that
+// responds with a single limit.
+func HandleGetLimitSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/limits/25a04c7a065c430590881c646cdcdd58", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, GetOutput)
+	})
+}
+
+// HandleUpdateLimitSuccessfully creates an HTTP handler at
+// `/limits/25a04c7a065c430590881c646cdcdd58` on the test handler mux that
+// tests limit update.
+func HandleUpdateLimitSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/limits/25a04c7a065c430590881c646cdcdd58", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PATCH")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, UpdateOutput)
+	})
+}
+
+// HandleDeleteLimitSuccessfully creates an HTTP handler at
+// `/limits/25a04c7a065c430590881c646cdcdd58` on the test handler mux that
+// tests limit deletion.
+func HandleDeleteLimitSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/limits/25a04c7a065c430590881c646cdcdd58", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,124 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/limits"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestGetEnforcementModel(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetEnforcementModelSuccessfully(t)
+
+	actual, err := limits.GetEnforcementModel(client.ServiceClient()).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, FlatModel, *actual)
+}
+
+func TestListLimits(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListLimitsSuccessfully(t)
+
+	listOpts := limits.ListOpts{
+		ProjectID: "3a705b9f56bb439381b43c4fe59dccce",
+	}
+
+	count := 0
+	err := limits.List(client.ServiceClient(), listOpts).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+
+		actual, err := limits.ExtractLimits(page)
+		th.AssertNoErr(t, err)
+
+		th.CheckDeepEquals(t, ExpectedLimitsSlice, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, count, 1)
+}
+
+func TestBatchCreateLimits(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleBatchCreateLimitsSuccessfully(t)
+
+	createOpts := limits.BatchCreateOpts{
+		{
+			ServiceID:     "9408080f1970482aa0e38bc2d4ea34b7",
+			ProjectID:     "3a705b9f56bb439381b43c4fe59dccce",
+			ResourceName:  "cores",
+			ResourceLimit: 40,
+			Description:   "Number of cores for project 3a705b",
+		},
+		{
+			ServiceID:     "9408080f1970482aa0e38bc2d4ea34b7",
+			ProjectID:     "3a705b9f56bb439381b43c4fe59dccce",
+			RegionID:      "RegionOne",
+			ResourceName:  "ram_mb",
+			ResourceLimit: 102400,
+		},
+	}
+
+	actual, err := limits.BatchCreate(client.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedLimitsSlice, actual)
+}
+
+func TestBatchCreateLimitsMissingServiceID(t *testing.T) {
+	createOpts := limits.BatchCreateOpts{
+		{
+			ProjectID:     "3a705b9f56bb439381b43c4fe59dccce",
+			ResourceName:  "cores",
+			ResourceLimit: 40,
+		},
+	}
+
+	_, err := limits.BatchCreate(client.ServiceClient(), createOpts).Extract()
+	if _, ok := err.(gophercloud.ErrMissingInput); !ok {
+		t.Fatalf("Expected ErrMissingInput, got %T: %v", err, err)
+	}
+}
+
+func TestGetLimit(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetLimitSuccessfully(t)
+
+	actual, err := limits.Get(client.ServiceClient(), "25a04c7a065c430590881c646cdcdd58").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, FirstLimit, *actual)
+}
+
+func TestUpdateLimit(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateLimitSuccessfully(t)
+
+	resourceLimit := 80
+	updateOpts := limits.UpdateOpts{
+		ResourceLimit: &resourceLimit,
+	}
+
+	expected := FirstLimit
+	expected.ResourceLimit = 80
+
+	actual, err := limits.Update(client.ServiceClient(), "25a04c7a065c430590881c646cdcdd58", updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, expected, *actual)
+}
+
+func TestDeleteLimit(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteLimitSuccessfully(t)
+
+	res := limits.Delete(client.ServiceClient(), "25a04c7a065c430590881c646cdcdd58")
+	th.AssertNoErr(t, res.Err)
+}
@@ -0,0 +1,20 @@
+package limits
+
+import "github.com/gophercloud/gophercloud"
+
+const (
+	rootPath  = "limits"
+	modelPath = "model"
+)
+
+func enforcementModelURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL(rootPath, modelPath)
+}
+
+func rootURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL(rootPath)
+}
+
+func resourceURL(client *gophercloud.ServiceClient, limitID string) string {
+	return client.ServiceURL(rootPath, limitID)
+}
@@ -22,6 +22,38 @@ Example to List Projects
 		fmt.Printf("%+v\n", project)
 	}
 
+Example to List Projects by Tags
+
+	listOpts := projects.ListOpts{
+		Tags:    "production,web",
+		NotTags: "deprecated",
+	}
+
+	allPages, err := projects.List(identityClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Get the Parents and the Subtree of a Project
+
+	projectID := "966b3c7d36a24facaf20b7e458bf2192"
+
+	getOpts := projects.GetHierarchyOpts{
+		ParentsAsList: true,
+		SubtreeAsIDs:  true,
+	}
+
+	result := projects.GetHierarchy(identityClient, projectID, getOpts)
+	parents, err := result.ExtractParents()
+	if err != nil {
+		panic(err)
+	}
+
+	subtreeIDs, err := result.ExtractSubtreeIDs()
+	if err != nil {
+		panic(err)
+	}
+
 Example to Create a Project
 
 	createOpts := projects.CreateOpts{
@@ -54,5 +86,40 @@ Example to Delete a Project
 	if err != nil {
 		panic(err)
 	}
+
+Example to Replace the Tags of a Project
+
+	projectID := "966b3c7d36a24facaf20b7e458bf2192"
+
+	modifyOpts := projects.ModifyTagsOpts{
+		Tags: []string{"production", "web"},
+	}
+
+	tags, err := projects.ModifyTags(identityClient, projectID, modifyOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Add a Tag to a Project
+
+	projectID := "966b3c7d36a24facaf20b7e458bf2192"
+	err := projects.AddTag(identityClient, projectID, "database").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Print the Project Tree of a Domain
+
+	roots, err := projects.ListTree(identityClient, "default")
+	if err != nil {
+		panic(err)
+	}
+
+	for _, root := range roots {
+		root.Walk(func(node *projects.TreeNode, depth int) error {
+			fmt.Printf("%s%s\n", strings.Repeat("  ", depth), node.Project.Name)
+			return nil
+		})
+	}
 */
 package projects
@@ -15,3 +15,14 @@ func (e InvalidListFilter) Error() string {
 	)
 	return s
 }
+
+// ErrParentNotInTree is returned by BuildTree when the parent of a project
+// isn't part of the tree of its domain.
+type ErrParentNotInTree struct {
+	ProjectID string
+	ParentID  string
+}
+
+func (e ErrParentNotInTree) Error() string {
+	return fmt.Sprintf("parent %s of project %s is not part of the tree of the domain", e.ParentID, e.ProjectID)
+}
@@ -32,6 +32,22 @@ type ListOpts struct {
 	// ParentID filters the response by projects of a given parent project.
 	ParentID string `q:"parent_id"`
 
+	// Tags filters the response by projects having all of the given
+	// comma-separated tags.
+	Tags string `q:"tags"`
+
+	// TagsAny filters the response by projects having at least one of the
+	// given comma-separated tags.
+	TagsAny string `q:"tags-any"`
+
+	// NotTags filters the response by projects not having all of the given
+	// comma-separated tags.
+	NotTags string `q:"not-tags"`
+
+	// NotTagsAny filters the response by projects having none of the given
+	// comma-separated tags.
+	NotTagsAny string `q:"not-tags-any"`
+
 	// Filters filters the response by custom filters such as
 	// 'name__contains=foo'
 	Filters map[string]string `q:"-"`
@@ -75,7 +91,56 @@ func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pa
 
 // Get retrieves details on a single project, by ID.
 func Get(client *gophercloud.ServiceClient, id string) (r GetResult) {
-	_, r.Err = client.Get(getURL(client, id), &r.Body, nil)
+	resp, err := client.Get(getURL(client, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// GetHierarchyOptsBuilder allows extensions to add additional parameters to
+// the GetHierarchy request.
+type GetHierarchyOptsBuilder interface {
+	ToProjectGetHierarchyQuery() (string, error)
+}
+
+// GetHierarchyOpts selects the parents and the subtree of the project to
+// include in a GetHierarchy response. Only the projects the user has a role
+// on are included as lists.
+type GetHierarchyOpts struct {
+	// ParentsAsList includes the parents of the project, nearest first.
+	ParentsAsList bool `q:"parents_as_list"`
+
+	// SubtreeAsList includes the projects of the subtree of the project.
+	SubtreeAsList bool `q:"subtree_as_list"`
+
+	// ParentsAsIDs includes the IDs of the parents of the project, nested
+	// from the nearest one up.
+	ParentsAsIDs bool `q:"parents_as_ids"`
+
+	// SubtreeAsIDs includes the IDs of the projects of the subtree of the
+	// project, nested from its children down.
+	SubtreeAsIDs bool `q:"subtree_as_ids"`
+}
+
+// ToProjectGetHierarchyQuery formats a GetHierarchyOpts into a query string.
+func (opts GetHierarchyOpts) ToProjectGetHierarchyQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// GetHierarchy retrieves details on a single project, by ID, along with its
+// parents and its subtree as selected by opts.
+func GetHierarchy(client *gophercloud.ServiceClient, id string, opts GetHierarchyOptsBuilder) (r GetHierarchyResult) {
+	url := getURL(client, id)
+	if opts != nil {
+		query, err := opts.ToProjectGetHierarchyQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += query
+	}
+	resp, err := client.Get(url, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
 
@@ -104,6 +169,9 @@ type CreateOpts struct {
 
 	// Description is the description of the project.
 	Description string `json:"description,omitempty"`
+
+	// Tags is a list of tags to associate with the project.
+	Tags []string `json:"tags,omitempty"`
 }
 
 // ToProjectCreateMap formats a CreateOpts into a create request.
@@ -118,13 +186,15 @@ func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r Create
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(createURL(client), &b, &r.Body, nil)
+	resp, err := client.Post(createURL(client), &b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
 
 // Delete deletes a project.
 func Delete(client *gophercloud.ServiceClient, projectID string) (r DeleteResult) {
-	_, r.Err = client.Delete(deleteURL(client, projectID), nil)
+	resp, err := client.Delete(deleteURL(client, projectID), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
 
@@ -153,6 +223,10 @@ type UpdateOpts struct {
 
 	// Description is the description of the project.
 	Description string `json:"description,omitempty"`
+
+	// Tags replaces the tags of the project. An empty list removes all of
+	// them.
+	Tags *[]string `json:"tags,omitempty"`
 }
 
 // ToUpdateCreateMap formats a UpdateOpts into an update request.
@@ -167,8 +241,83 @@ func Update(client *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Patch(updateURL(client, id), b, &r.Body, &gophercloud.RequestOpts{
+	resp, err := client.Patch(updateURL(client, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ListTags lists the tags of a project.
+func ListTags(client *gophercloud.ServiceClient, projectID string) (r ListTagsResult) {
+	resp, err := client.Get(listTagsURL(client, projectID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ModifyTagsOptsBuilder allows extensions to add additional parameters to
+// the ModifyTags request.
+type ModifyTagsOptsBuilder interface {
+	ToProjectModifyTagsMap() (map[string]interface{}, error)
+}
+
+// ModifyTagsOpts represents the tags replacing those of a project.
+type ModifyTagsOpts struct {
+	// Tags is the new list of tags of the project.
+	Tags []string `json:"tags"`
+}
+
+// ToProjectModifyTagsMap formats a ModifyTagsOpts into a request body.
+func (opts ModifyTagsOpts) ToProjectModifyTagsMap() (map[string]interface{}, error) {
+	if opts.Tags == nil {
+		opts.Tags = []string{}
+	}
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// ModifyTags replaces all the tags of a project.
+func ModifyTags(client *gophercloud.ServiceClient, projectID string, opts ModifyTagsOptsBuilder) (r ModifyTagsResult) {
+	b, err := opts.ToProjectModifyTagsMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Put(listTagsURL(client, projectID), &b, &r.Body, &gophercloud.RequestOpts{
 		OkCodes: []int{200},
 	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// DeleteTags removes all the tags of a project.
+func DeleteTags(client *gophercloud.ServiceClient, projectID string) (r DeleteTagsResult) {
+	resp, err := client.Delete(listTagsURL(client, projectID), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// AddTag adds a single tag to a project.
+func AddTag(client *gophercloud.ServiceClient, projectID, tag string) (r AddTagResult) {
+	resp, err := client.Put(tagURL(client, projectID, tag), nil, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// CheckTag checks whether a project has a tag. The request fails with a
+// gophercloud.ErrDefault404 if it doesn't.
+func CheckTag(client *gophercloud.ServiceClient, projectID, tag string) (r CheckTagResult) {
+	resp, err := client.Get(tagURL(client, projectID, tag), nil, &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// DeleteTag removes a single tag from a project.
+func DeleteTag(client *gophercloud.ServiceClient, projectID, tag string) (r DeleteTagResult) {
+	resp, err := client.Delete(tagURL(client, projectID, tag), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
@@ -55,6 +55,9 @@ type Project struct {
 
 	// ParentID is the parent_id of the project.
 	ParentID string `json:"parent_id"`
+
+	// Tags is the list of tags associated with the project.
+	Tags []string `json:"tags"`
 }
 
 // ProjectPage is a single page of Project results.
@@ -101,3 +104,129 @@ func (r projectResult) Extract() (*Project, error) {
 	err := r.ExtractInto(&s)
 	return s.Project, err
 }
+
+// GetHierarchyResult is the result of a GetHierarchy request. Call its
+// Extract method to interpret it as a Project, and its other methods to get
+// the parents and the subtree of the project.
+type GetHierarchyResult struct {
+	projectResult
+}
+
+// ProjectIDs is a hierarchy of project IDs, mapping the ID of each project
+// to the next level of the hierarchy. The map of the last level is nil.
+type ProjectIDs map[string]ProjectIDs
+
+// ExtractParents returns the parents of the project, from the nearest one
+// up, when requested with ParentsAsList.
+func (r GetHierarchyResult) ExtractParents() ([]Project, error) {
+	var s struct {
+		Project struct {
+			Parents []wrappedProject `json:"parents"`
+		} `json:"project"`
+	}
+	err := r.ExtractInto(&s)
+	return unwrapProjects(s.Project.Parents), err
+}
+
+// ExtractSubtree returns the projects of the subtree of the project, when
+// requested with SubtreeAsList.
+func (r GetHierarchyResult) ExtractSubtree() ([]Project, error) {
+	var s struct {
+		Project struct {
+			Subtree []wrappedProject `json:"subtree"`
+		} `json:"project"`
+	}
+	err := r.ExtractInto(&s)
+	return unwrapProjects(s.Project.Subtree), err
+}
+
+// wrappedProject is a project in the lists of parents and subtree.
+type wrappedProject struct {
+	Project Project `json:"project"`
+}
+
+func unwrapProjects(wrapped []wrappedProject) []Project {
+	if wrapped == nil {
+		return nil
+	}
+	projects := make([]Project, len(wrapped))
+	for i, w := range wrapped {
+		projects[i] = w.Project
+	}
+	return projects
+}
+
+// ExtractParentIDs returns the IDs of the parents of the project, when
+// requested with ParentsAsIDs. The map holds the parent of the project,
+// mapped to its own parent, and so on.
+func (r GetHierarchyResult) ExtractParentIDs() (ProjectIDs, error) {
+	var s struct {
+		Project struct {
+			Parents ProjectIDs `json:"parents"`
+		} `json:"project"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Project.Parents, err
+}
+
+// ExtractSubtreeIDs returns the IDs of the projects of the subtree of the
+// project, when requested with SubtreeAsIDs. The map holds the children of
+// the project, each mapped to its own children, and so on.
+func (r GetHierarchyResult) ExtractSubtreeIDs() (ProjectIDs, error) {
+	var s struct {
+		Project struct {
+			Subtree ProjectIDs `json:"subtree"`
+		} `json:"project"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Project.Subtree, err
+}
+
+type tagsResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets any tagsResult as the list of tags of a project.
+func (r tagsResult) Extract() ([]string, error) {
+	var s struct {
+		Tags []string `json:"tags"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Tags, err
+}
+
+// ListTagsResult is the result of a ListTags request. Call its Extract method
+// to interpret it as a list of tags.
+type ListTagsResult struct {
+	tagsResult
+}
+
+// ModifyTagsResult is the result of a ModifyTags request. Call its Extract
+// method to interpret it as a list of tags.
+type ModifyTagsResult struct {
+	tagsResult
+}
+
+// DeleteTagsResult is the result of a DeleteTags request. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type DeleteTagsResult struct {
+	gophercloud.ErrResult
+}
+
+// AddTagResult is the result of an AddTag request. Call its ExtractErr method
+// to determine if the request succeeded or failed.
+type AddTagResult struct {
+	gophercloud.ErrResult
+}
+
+// CheckTagResult is the result of a CheckTag request. Call its ExtractErr
+// method to determine if the project has the tag.
+type CheckTagResult struct {
+	gophercloud.ErrResult
+}
+
+// DeleteTagResult is the result of a DeleteTag request. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type DeleteTagResult struct {
+	gophercloud.ErrResult
+}
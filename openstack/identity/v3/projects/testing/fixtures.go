@@ -190,3 +190,209 @@ func HandleUpdateProjectSuccessfully(t *testing.T) {
 		fmt.Fprintf(w, UpdateOutput)
 	})
 }
+
+// GetHierarchyListOutput provides a GetHierarchy result with the parents and
+// the subtree as lists.
+const GetHierarchyListOutput = `
+{
+  "project": {
+    "is_domain": false,
+    "description": "The team that is red",
+    "domain_id": "default",
+    "enabled": true,
+    "id": "1234",
+    "name": "Red Team",
+    "parent_id": "5678",
+    "parents": [
+      {
+        "project": {
+          "is_domain": false,
+          "description": "The teams",
+          "domain_id": "default",
+          "enabled": true,
+          "id": "5678",
+          "name": "Teams",
+          "parent_id": "default"
+        }
+      }
+    ],
+    "subtree": [
+      {
+        "project": {
+          "is_domain": false,
+          "description": "The team that is dark red",
+          "domain_id": "default",
+          "enabled": true,
+          "id": "4321",
+          "name": "Dark Red Team",
+          "parent_id": "1234"
+        }
+      }
+    ]
+  }
+}
+`
+
+// GetHierarchyIDsOutput provides a GetHierarchy result with the parents and
+// the subtree as IDs.
+const GetHierarchyIDsOutput = `
+{
+  "project": {
+    "is_domain": false,
+    "description": "The team that is red",
+    "domain_id": "default",
+    "enabled": true,
+    "id": "1234",
+    "name": "Red Team",
+    "parent_id": "5678",
+    "parents": {
+      "5678": {
+        "default": null
+      }
+    },
+    "subtree": {
+      "4321": null,
+      "8765": {
+        "2109": null
+      }
+    }
+  }
+}
+`
+
+// ListTagsOutput provides the tags of a project.
+const ListTagsOutput = `
+{
+  "links": {
+    "self": "http://example.com/identity/v3/projects/1234/tags"
+  },
+  "tags": ["red", "team"]
+}
+`
+
+// ModifyTagsRequest provides the input to a ModifyTags request.
+const ModifyTagsRequest = `
+{
+  "tags": ["red", "team"]
+}
+`
+
+// TeamsProject is the parent of RedTeam in the GetHierarchy results.
+var TeamsProject = projects.Project{
+	Description: "The teams",
+	DomainID:    "default",
+	Enabled:     true,
+	ID:          "5678",
+	Name:        "Teams",
+	ParentID:    "default",
+}
+
+// DarkRedTeam is the child of RedTeam in the GetHierarchy results.
+var DarkRedTeam = projects.Project{
+	Description: "The team that is dark red",
+	DomainID:    "default",
+	Enabled:     true,
+	ID:          "4321",
+	Name:        "Dark Red Team",
+	ParentID:    "1234",
+}
+
+// HandleGetHierarchySuccessfully creates an HTTP handler at `/projects/1234`
+// on the test handler mux that responds with the parents and the subtree of
+// the project as lists or as IDs, depending on the query.
+func HandleGetHierarchySuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/projects/1234", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if r.URL.Query().Get("parents_as_list") == "true" {
+			th.TestFormValues(t, r, map[string]string{"parents_as_list": "true", "subtree_as_list": "true"})
+			fmt.Fprintf(w, GetHierarchyListOutput)
+		} else {
+			th.TestFormValues(t, r, map[string]string{"parents_as_ids": "true", "subtree_as_ids": "true"})
+			fmt.Fprintf(w, GetHierarchyIDsOutput)
+		}
+	})
+}
+
+// HandleProjectTagsSuccessfully creates HTTP handlers at
+// `/projects/1234/tags` on the test handler mux that list, replace and
+// delete the tags of a project, and check, add or delete the single tags
+// "red" and "dark red".
+func HandleProjectTagsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/projects/1234/tags", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		switch r.Method {
+		case "GET":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, ListTagsOutput)
+		case "PUT":
+			th.TestJSONRequest(t, r, ModifyTagsRequest)
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, ListTagsOutput)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+
+	th.Mux.HandleFunc("/projects/1234/tags/red", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		switch r.Method {
+		case "GET", "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+
+	th.Mux.HandleFunc("/projects/1234/tags/dark red", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		switch r.Method {
+		case "PUT":
+			w.WriteHeader(http.StatusCreated)
+		case "GET":
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+}
+
+// HandleListDomainProjectsSuccessfully creates an HTTP handler at `/projects`
+// on the test handler mux that responds with the projects of the default
+// domain, forming two trees.
+func HandleListDomainProjectsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/projects", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"domain_id": "default"})
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `
+{
+  "projects": [
+    {"id": "4321", "name": "Dark Red Team", "domain_id": "default", "parent_id": "1234"},
+    {"id": "5678", "name": "Teams", "domain_id": "default", "parent_id": "default"},
+    {"id": "1234", "name": "Red Team", "domain_id": "default", "parent_id": "5678"},
+    {"id": "9876", "name": "Blue Team", "domain_id": "default", "parent_id": "5678"},
+    {"id": "2468", "name": "Ops", "domain_id": "default", "parent_id": "default"}
+  ],
+  "links": {
+    "next": null,
+    "previous": null
+  }
+}
+`)
+	})
+}
@@ -3,6 +3,7 @@ package testing
 import (
 	"testing"
 
+	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/identity/v3/projects"
 	"github.com/gophercloud/gophercloud/pagination"
 	th "github.com/gophercloud/gophercloud/testhelper"
@@ -109,3 +110,125 @@ func TestUpdateProject(t *testing.T) {
 	th.AssertNoErr(t, err)
 	th.CheckDeepEquals(t, UpdatedRedTeam, *actual)
 }
+
+func TestListProjectsTagsFilters(t *testing.T) {
+	listOpts := projects.ListOpts{
+		Tags:       "red,team",
+		TagsAny:    "blue",
+		NotTags:    "old",
+		NotTagsAny: "deleted,hidden",
+	}
+
+	query, err := listOpts.ToProjectListQuery()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "?not-tags=old&not-tags-any=deleted%2Chidden&tags=red%2Cteam&tags-any=blue", query)
+}
+
+func TestGetHierarchyAsList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetHierarchySuccessfully(t)
+
+	getOpts := projects.GetHierarchyOpts{
+		ParentsAsList: true,
+		SubtreeAsList: true,
+	}
+	result := projects.GetHierarchy(client.ServiceClient(), "1234", getOpts)
+
+	expected := RedTeam
+	expected.ParentID = "5678"
+	actual, err := result.Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, expected, *actual)
+
+	parents, err := result.ExtractParents()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []projects.Project{TeamsProject}, parents)
+
+	subtree, err := result.ExtractSubtree()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []projects.Project{DarkRedTeam}, subtree)
+}
+
+func TestGetHierarchyAsIDs(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetHierarchySuccessfully(t)
+
+	getOpts := projects.GetHierarchyOpts{
+		ParentsAsIDs: true,
+		SubtreeAsIDs: true,
+	}
+	result := projects.GetHierarchy(client.ServiceClient(), "1234", getOpts)
+
+	parents, err := result.ExtractParentIDs()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, projects.ProjectIDs{"5678": {"default": nil}}, parents)
+
+	subtree, err := result.ExtractSubtreeIDs()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, projects.ProjectIDs{"4321": nil, "8765": {"2109": nil}}, subtree)
+}
+
+func TestProjectTags(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleProjectTagsSuccessfully(t)
+
+	tags, err := projects.ListTags(client.ServiceClient(), "1234").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []string{"red", "team"}, tags)
+
+	modifyOpts := projects.ModifyTagsOpts{
+		Tags: []string{"red", "team"},
+	}
+	tags, err = projects.ModifyTags(client.ServiceClient(), "1234", modifyOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []string{"red", "team"}, tags)
+
+	err = projects.DeleteTags(client.ServiceClient(), "1234").ExtractErr()
+	th.AssertNoErr(t, err)
+
+	err = projects.AddTag(client.ServiceClient(), "1234", "dark red").ExtractErr()
+	th.AssertNoErr(t, err)
+
+	err = projects.CheckTag(client.ServiceClient(), "1234", "red").ExtractErr()
+	th.AssertNoErr(t, err)
+
+	err = projects.CheckTag(client.ServiceClient(), "1234", "dark red").ExtractErr()
+	if _, ok := err.(gophercloud.ErrDefault404); !ok {
+		t.Fatalf("Expected ErrDefault404, got %T: %v", err, err)
+	}
+
+	err = projects.DeleteTag(client.ServiceClient(), "1234", "red").ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestCreateProjectWithTags(t *testing.T) {
+	createOpts := projects.CreateOpts{
+		Name: "Red Team",
+		Tags: []string{"red", "team"},
+	}
+
+	actual, err := createOpts.ToProjectCreateMap()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, map[string]interface{}{
+		"project": map[string]interface{}{
+			"name": "Red Team",
+			"tags": []interface{}{"red", "team"},
+		},
+	}, actual)
+
+	tags := []string{}
+	updateOpts := projects.UpdateOpts{
+		Tags: &tags,
+	}
+
+	actual, err = updateOpts.ToProjectUpdateMap()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, map[string]interface{}{
+		"project": map[string]interface{}{
+			"tags": []interface{}{},
+		},
+	}, actual)
+}
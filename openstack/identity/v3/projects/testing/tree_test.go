@@ -0,0 +1,88 @@
+package testing
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/projects"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// flattenTree describes the nodes of trees in walking order.
+func flattenTree(t *testing.T, roots []*projects.TreeNode) []string {
+	var nodes []string
+	for _, root := range roots {
+		err := root.Walk(func(node *projects.TreeNode, depth int) error {
+			nodes = append(nodes, fmt.Sprintf("%d:%s", depth, node.Project.Name))
+			return nil
+		})
+		th.AssertNoErr(t, err)
+	}
+	return nodes
+}
+
+func TestListTree(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListDomainProjectsSuccessfully(t)
+
+	roots, err := projects.ListTree(client.ServiceClient(), "default")
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []string{
+		"0:Teams",
+		"1:Red Team",
+		"2:Dark Red Team",
+		"1:Blue Team",
+		"0:Ops",
+	}, flattenTree(t, roots))
+}
+
+func TestBuildTreeIgnoresOtherDomains(t *testing.T) {
+	roots, err := projects.BuildTree("default", []projects.Project{
+		{ID: "default", Name: "Default", IsDomain: true},
+		{ID: "1234", Name: "Red Team", DomainID: "default", ParentID: "default"},
+		{ID: "9876", Name: "Blue Team", DomainID: "other", ParentID: "other"},
+		{ID: "4321", Name: "Dark Red Team", DomainID: "default", ParentID: "1234"},
+	})
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []string{"0:Red Team", "1:Dark Red Team"}, flattenTree(t, roots))
+}
+
+func TestBuildTreeParentNotInTree(t *testing.T) {
+	for _, list := range [][]projects.Project{
+		{
+			{ID: "4321", DomainID: "default", ParentID: "1234"},
+		},
+		{
+			{ID: "1234", DomainID: "default", ParentID: "4321"},
+			{ID: "4321", DomainID: "default", ParentID: "1234"},
+		},
+	} {
+		_, err := projects.BuildTree("default", list)
+		if _, ok := err.(projects.ErrParentNotInTree); !ok {
+			t.Fatalf("Expected ErrParentNotInTree, got %T: %v", err, err)
+		}
+	}
+}
+
+func TestWalkStops(t *testing.T) {
+	roots, err := projects.BuildTree("default", []projects.Project{
+		{ID: "1234", DomainID: "default"},
+		{ID: "4321", DomainID: "default", ParentID: "1234"},
+		{ID: "8765", DomainID: "default", ParentID: "1234"},
+	})
+	th.AssertNoErr(t, err)
+
+	stop := fmt.Errorf("stop")
+	visited := 0
+	err = roots[0].Walk(func(node *projects.TreeNode, depth int) error {
+		visited++
+		if node.Project.ID == "4321" {
+			return stop
+		}
+		return nil
+	})
+	th.CheckEquals(t, stop, err)
+	th.CheckEquals(t, 2, visited)
+}
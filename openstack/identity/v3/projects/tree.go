@@ -0,0 +1,93 @@
+package projects
+
+import "github.com/gophercloud/gophercloud"
+
+// TreeNode is a project of a project tree, along with its children.
+type TreeNode struct {
+	Project  Project
+	Children []*TreeNode
+}
+
+// Walk calls fn for the node and all its descendants, depth first, each
+// parent before its children. depth is 0 for the node itself. Walk stops at
+// the first error returned by fn, and returns it.
+func (n *TreeNode) Walk(fn func(node *TreeNode, depth int) error) error {
+	return n.walk(fn, 0)
+}
+
+func (n *TreeNode) walk(fn func(node *TreeNode, depth int) error, depth int) error {
+	if err := fn(n, depth); err != nil {
+		return err
+	}
+	for _, child := range n.Children {
+		if err := child.walk(fn, depth+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// BuildTree builds the tree of the projects of a domain from a list of
+// projects, such as the result of List. The projects of other domains, and
+// the projects acting as domains, are ignored.
+//
+// It returns the top-level projects of the domain, in the order of the list,
+// as are the children of each project. It returns an ErrParentNotInTree if
+// a project can't be attached to the tree.
+func BuildTree(domainID string, projects []Project) ([]*TreeNode, error) {
+	nodes := make(map[string]*TreeNode)
+	var ordered []*TreeNode
+	for _, p := range projects {
+		if p.IsDomain || p.DomainID != domainID {
+			continue
+		}
+		node := &TreeNode{Project: p}
+		nodes[p.ID] = node
+		ordered = append(ordered, node)
+	}
+
+	var roots []*TreeNode
+	for _, node := range ordered {
+		parentID := node.Project.ParentID
+		if parentID == "" || parentID == domainID {
+			roots = append(roots, node)
+			continue
+		}
+		parent, ok := nodes[parentID]
+		if !ok {
+			return nil, ErrParentNotInTree{ProjectID: node.Project.ID, ParentID: parentID}
+		}
+		parent.Children = append(parent.Children, node)
+	}
+
+	// Projects whose parents form a cycle are attached to each other, but not
+	// to a top-level project.
+	attached := make(map[string]bool, len(ordered))
+	for _, root := range roots {
+		root.Walk(func(node *TreeNode, _ int) error {
+			attached[node.Project.ID] = true
+			return nil
+		})
+	}
+	for _, node := range ordered {
+		if !attached[node.Project.ID] {
+			return nil, ErrParentNotInTree{ProjectID: node.Project.ID, ParentID: node.Project.ParentID}
+		}
+	}
+
+	return roots, nil
+}
+
+// ListTree lists the projects of a domain and builds their tree with
+// BuildTree.
+func ListTree(client *gophercloud.ServiceClient, domainID string) ([]*TreeNode, error) {
+	allPages, err := List(client, ListOpts{DomainID: domainID}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	allProjects, err := ExtractProjects(allPages)
+	if err != nil {
+		return nil, err
+	}
+	return BuildTree(domainID, allProjects)
+}
@@ -1,6 +1,10 @@
 package projects
 
-import "github.com/gophercloud/gophercloud"
+import (
+	"net/url"
+
+	"github.com/gophercloud/gophercloud"
+)
 
 func listURL(client *gophercloud.ServiceClient) string {
 	return client.ServiceURL("projects")
@@ -21,3 +25,11 @@ func deleteURL(client *gophercloud.ServiceClient, projectID string) string {
 func updateURL(client *gophercloud.ServiceClient, projectID string) string {
 	return client.ServiceURL("projects", projectID)
 }
+
+func listTagsURL(client *gophercloud.ServiceClient, projectID string) string {
+	return client.ServiceURL("projects", projectID, "tags")
+}
+
+func tagURL(client *gophercloud.ServiceClient, projectID, tag string) string {
+	return client.ServiceURL("projects", projectID, "tags", url.PathEscape(tag))
+}
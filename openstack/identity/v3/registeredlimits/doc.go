@@ -0,0 +1,65 @@
+/*
+Package registeredlimits manages the registered limits of the OpenStack
+Identity service, the default limits of the resources of the services.
+
+Example to List Registered Limits
+
+	listOpts := registeredlimits.ListOpts{
+		ServiceID: "9408080f1970482aa0e38bc2d4ea34b7",
+	}
+
+	allPages, err := registeredlimits.List(identityClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allLimits, err := registeredlimits.ExtractRegisteredLimits(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, limit := range allLimits {
+		fmt.Printf("%s: %d\n", limit.ResourceName, limit.DefaultLimit)
+	}
+
+Example to Register Default Limits
+
+	createOpts := registeredlimits.BatchCreateOpts{
+		{
+			ServiceID:    "9408080f1970482aa0e38bc2d4ea34b7",
+			ResourceName: "cores",
+			DefaultLimit: 20,
+		},
+		{
+			ServiceID:    "9408080f1970482aa0e38bc2d4ea34b7",
+			ResourceName: "ram_mb",
+			DefaultLimit: 51200,
+			RegionID:     "RegionOne",
+		},
+	}
+
+	limits, err := registeredlimits.BatchCreate(identityClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update a Registered Limit
+
+	defaultLimit := 40
+	updateOpts := registeredlimits.UpdateOpts{
+		DefaultLimit: &defaultLimit,
+	}
+
+	limit, err := registeredlimits.Update(identityClient, "3229b3849f584faea483d6851f7aab05", updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Registered Limit
+
+	err := registeredlimits.Delete(identityClient, "3229b3849f584faea483d6851f7aab05").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package registeredlimits
@@ -0,0 +1,115 @@
+package registeredlimits
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// RegisteredLimit is the default limit of a resource, which applies to the
+// projects without a project limit.
+type RegisteredLimit struct {
+	// ID is the unique ID of the registered limit.
+	ID string `json:"id"`
+
+	// ServiceID is the ID of the service owning the resource.
+	ServiceID string `json:"service_id"`
+
+	// ResourceName is the name of the resource.
+	ResourceName string `json:"resource_name"`
+
+	// RegionID is the region the limit applies to. It is empty if it
+	// applies to every region.
+	RegionID string `json:"region_id"`
+
+	// DefaultLimit is the limit of the projects without a project limit.
+	DefaultLimit int `json:"default_limit"`
+
+	// Description is a description of the registered limit.
+	Description string `json:"description"`
+
+	// Links contains referencing links to the registered limit.
+	Links map[string]interface{} `json:"links"`
+}
+
+type registeredLimitResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets any registered limit result as a RegisteredLimit.
+func (r registeredLimitResult) Extract() (*RegisteredLimit, error) {
+	var s struct {
+		RegisteredLimit *RegisteredLimit `json:"registered_limit"`
+	}
+	err := r.ExtractInto(&s)
+	return s.RegisteredLimit, err
+}
+
+// CreateResult is the response from a BatchCreate operation. Call its Extract
+// method to interpret it as a slice of RegisteredLimits.
+type CreateResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a CreateResult as the created RegisteredLimits.
+func (r CreateResult) Extract() ([]RegisteredLimit, error) {
+	var s struct {
+		RegisteredLimits []RegisteredLimit `json:"registered_limits"`
+	}
+	err := r.ExtractInto(&s)
+	return s.RegisteredLimits, err
+}
+
+// GetResult is the response from a Get operation. Call its Extract method
+// to interpret it as a RegisteredLimit.
+type GetResult struct {
+	registeredLimitResult
+}
+
+// UpdateResult is the response from an Update operation. Call its Extract
+// method to interpret it as a RegisteredLimit.
+type UpdateResult struct {
+	registeredLimitResult
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr to
+// determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// RegisteredLimitPage is a single page of RegisteredLimit results.
+type RegisteredLimitPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty determines whether or not a RegisteredLimitPage contains any
+// results.
+func (r RegisteredLimitPage) IsEmpty() (bool, error) {
+	registeredLimits, err := ExtractRegisteredLimits(r)
+	return len(registeredLimits) == 0, err
+}
+
+// NextPageURL extracts the "next" link from the links section of the result.
+func (r RegisteredLimitPage) NextPageURL() (string, error) {
+	var s struct {
+		Links struct {
+			Next     string `json:"next"`
+			Previous string `json:"previous"`
+		} `json:"links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return s.Links.Next, err
+}
+
+// ExtractRegisteredLimits returns a slice of RegisteredLimits contained in a
+// single page of results.
+func ExtractRegisteredLimits(r pagination.Page) ([]RegisteredLimit, error) {
+	var s struct {
+		RegisteredLimits []RegisteredLimit `json:"registered_limits"`
+	}
+	err := (r.(RegisteredLimitPage)).ExtractInto(&s)
+	return s.RegisteredLimits, err
+}
@@ -0,0 +1,222 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/registeredlimits"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// ListOutput provides a single page of RegisteredLimit results.
+const ListOutput = `
+{
+    "links": {
+        "self": "http://example.com/identity/v3/registered_limits",
+        "previous": null,
+        "next": null
+    },
+    "registered_limits": [
+        {
+            "id": "773147dd53cd4a17b921d555cf17c633",
+            "service_id": "9408080f1970482aa0e38bc2d4ea34b7",
+            "resource_name": "cores",
+            "region_id": null,
+            "default_limit": 10,
+            "description": "Number of cores",
+            "links": {
+                "self": "http://example.com/identity/v3/registered_limits/773147dd53cd4a17b921d555cf17c633"
+            }
+        },
+        {
+            "id": "e35a965b2b42485d884e8b7c63fd5a7d",
+            "service_id": "9408080f1970482aa0e38bc2d4ea34b7",
+            "resource_name": "ram_mb",
+            "region_id": "RegionOne",
+            "default_limit": 51200,
+            "description": null,
+            "links": {
+                "self": "http://example.com/identity/v3/registered_limits/e35a965b2b42485d884e8b7c63fd5a7d"
+            }
+        }
+    ]
+}
+`
+
+// GetOutput provides a Get result.
+const GetOutput = `
+{
+    "registered_limit": {
+        "id": "773147dd53cd4a17b921d555cf17c633",
+        "service_id": "9408080f1970482aa0e38bc2d4ea34b7",
+        "resource_name": "cores",
+        "region_id": null,
+        "default_limit": 10,
+        "description": "Number of cores",
+        "links": {
+            "self": "http://example.com/identity/v3/registered_limits/773147dd53cd4a17b921d555cf17c633"
+        }
+    }
+}
+`
+
+// CreateRequest provides the input to a BatchCreate request.
+const CreateRequest = `
+{
+    "registered_limits": [
+        {
+            "service_id": "9408080f1970482aa0e38bc2d4ea34b7",
+            "resource_name": "cores",
+            "default_limit": 10,
+            "description": "Number of cores"
+        },
+        {
+            "service_id": "9408080f1970482aa0e38bc2d4ea34b7",
+            "resource_name": "ram_mb",
+            "region_id": "RegionOne",
+            "default_limit": 51200
+        }
+    ]
+}
+`
+
+// UpdateRequest provides the input to an Update request.
+const UpdateRequest = `
+{
+    "registered_limit": {
+        "default_limit": 20,
+        "description": ""
+    }
+}
+`
+
+// UpdateOutput provides an Update result.
+const UpdateOutput = `
+{
+    "registered_limit": {
+        "id": "773147dd53cd4a17b921d555cf17c633",
+        "service_id": "9408080f1970482aa0e38bc2d4ea34b7",
+        "resource_name": "cores",
+        "region_id": null,
+        "default_limit": 20,
+        "description": "",
+        "links": {
+            "self": "http://example.com/identity/v3/registered_limits/773147dd53cd4a17b921d555cf17c633"
+        }
+    }
+}
+`
+
+// FirstRegisteredLimit is the first registered limit in the List request.
+var FirstRegisteredLimit = registeredlimits.RegisteredLimit{
+	ID:           "773147dd53cd4a17b921d555cf17c633",
+	ServiceID:    "9408080f1970482aa0e38bc2d4ea34b7",
+	ResourceName: "cores",
+	DefaultLimit: 10,
+	Description:  "Number of cores",
+	Links: map[string]interface{}{
+		"self": "http://example.com/identity/v3/registered_limits/773147dd53cd4a17b921d555cf17c633",
+	},
+}
+
+// SecondRegisteredLimit is the second registered limit in the List request.
+var SecondRegisteredLimit = registeredlimits.RegisteredLimit{
+	ID:           "e35a965b2b42485d884e8b7c63fd5a7d",
+	ServiceID:    "9408080f1970482aa0e38bc2d4ea34b7",
+	ResourceName: "ram_mb",
+	RegionID:     "RegionOne",
+	DefaultLimit: 51200,
+	Links: map[string]interface{}{
+		"self": "http://example.com/identity/v3/registered_limits/e35a965b2b42485d884e8b7c63fd5a7d",
+	},
+}
+
+// UpdatedRegisteredLimit is FirstRegisteredLimit, updated.
+var UpdatedRegisteredLimit = registeredlimits.RegisteredLimit{
+	ID:           "773147dd53cd4a17b921d555cf17c633",
+	ServiceID:    "9408080f1970482aa0e38bc2d4ea34b7",
+	ResourceName: "cores",
+	DefaultLimit: 20,
+	Links: map[string]interface{}{
+		"self": "http://example.com/identity/v3/registered_limits/773147dd53cd4a17b921d555cf17c633",
+	},
+}
+
+// ExpectedRegisteredLimitsSlice is the slice of registered limits expected to
+// be returned from ListOutput.
+var ExpectedRegisteredLimitsSlice = []registeredlimits.RegisteredLimit{FirstRegisteredLimit, SecondRegisteredLimit}
+
+// HandleListRegisteredLimitsSuccessfully creates an HTTP handler at
+// `/registered_limits` on the test handler mux that responds with a list of
+// two registered limits.
+func HandleListRegisteredLimitsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/registered_limits", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"service_id": "9408080f1970482aa0e38bc2d4ea34b7"})
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListOutput)
+	})
+}
+
+// HandleBatchCreateRegisteredLimitsSuccessfully creates an HTTP handler at
+// `/registered_limits` on the test handler mux that tests registered limit
+// creation.
+func HandleBatchCreateRegisteredLimitsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/registered_limits", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, ListOutput)
+	})
+}
+
+// HandleGetRegisteredLimitSuccessfully creates an HTTP handler at
+// `/registered_limits/773147dd53cd4a17b921d555cf17c633` on the test handler
+// mux that responds with a single registered limit.
+func HandleGetRegisteredLimitSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/registered_limits/773147dd53cd4a17b921d555cf17c633", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, GetOutput)
+	})
+}
+
+// HandleUpdateRegisteredLimitSuccessfully creates an HTTP handler at
+// `/registered_limits/773147dd53cd4a17b921d555cf17c633` on the test handler
+// mux that tests registered limit update.
+func HandleUpdateRegisteredLimitSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/registered_limits/773147dd53cd4a17b921d555cf17c633", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PATCH")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, UpdateOutput)
+	})
+}
+
+// HandleDeleteRegisteredLimitSuccessfully creates an HTTP handler at
+// `/registered_limits/773147dd53cd4a17b921d555cf17c633` on the test handler
+// mux that tests registered limit deletion.
+func HandleDeleteRegisteredLimitSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/registered_limits/773147dd53cd4a17b921d555cf17c633", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
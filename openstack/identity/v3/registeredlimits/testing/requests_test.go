@@ -0,0 +1,110 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/registeredlimits"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestListRegisteredLimits(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListRegisteredLimitsSuccessfully(t)
+
+	listOpts := registeredlimits.ListOpts{
+		ServiceID: "9408080f1970482aa0e38bc2d4ea34b7",
+	}
+
+	count := 0
+	err := registeredlimits.List(client.ServiceClient(), listOpts).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+
+		actual, err := registeredlimits.ExtractRegisteredLimits(page)
+		th.AssertNoErr(t, err)
+
+		th.CheckDeepEquals(t, ExpectedRegisteredLimitsSlice, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, count, 1)
+}
+
+func TestBatchCreateRegisteredLimits(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleBatchCreateRegisteredLimitsSuccessfully(t)
+
+	createOpts := registeredlimits.BatchCreateOpts{
+		{
+			ServiceID:    "9408080f1970482aa0e38bc2d4ea34b7",
+			ResourceName: "cores",
+			DefaultLimit: 10,
+			Description:  "Number of cores",
+		},
+		{
+			ServiceID:    "9408080f1970482aa0e38bc2d4ea34b7",
+			ResourceName: "ram_mb",
+			RegionID:     "RegionOne",
+			DefaultLimit: 51200,
+		},
+	}
+
+	actual, err := registeredlimits.BatchCreate(client.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedRegisteredLimitsSlice, actual)
+}
+
+func TestBatchCreateRegisteredLimitsMissingResourceName(t *testing.T) {
+	createOpts := registeredlimits.BatchCreateOpts{
+		{
+			ServiceID:    "9408080f1970482aa0e38bc2d4ea34b7",
+			DefaultLimit: 10,
+		},
+	}
+
+	_, err := registeredlimits.BatchCreate(client.ServiceClient(), createOpts).Extract()
+	if _, ok := err.(gophercloud.ErrMissingInput); !ok {
+		t.Fatalf("Expected ErrMissingInput, got %T: %v", err, err)
+	}
+}
+
+func TestGetRegisteredLimit(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetRegisteredLimitSuccessfully(t)
+
+	actual, err := registeredlimits.Get(client.ServiceClient(), "773147dd53cd4a17b921d555cf17c633").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, FirstRegisteredLimit, *actual)
+}
+
+func TestUpdateRegisteredLimit(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateRegisteredLimitSuccessfully(t)
+
+	defaultLimit := 20
+	description := ""
+	updateOpts := registeredlimits.UpdateOpts{
+		DefaultLimit: &defaultLimit,
+		Description:  &description,
+	}
+
+	actual, err := registeredlimits.Update(client.ServiceClient(), "773147dd53cd4a17b921d555cf17c633", updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, UpdatedRegisteredLimit, *actual)
+}
+
+func TestDeleteRegisteredLimit(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteRegisteredLimitSuccessfully(t)
+
+	res := registeredlimits.Delete(client.ServiceClient(), "773147dd53cd4a17b921d555cf17c633")
+	th.AssertNoErr(t, res.Err)
+}
@@ -131,5 +131,63 @@ Example to Unassign a Role From a User in a Project
 	if err != nil {
 		panic(err)
 	}
+
+Example to Create a Domain-Specific Role
+
+	createOpts := roles.CreateOpts{
+		Name:     "support",
+		DomainID: "1789d1",
+	}
+
+	role, err := roles.Create(identityClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Make a Role Imply Another Role
+
+	priorRoleID := "7ceab6192ea34a548cc71b24f72e762c"
+	impliedRoleID := "97e2f5d38bc94842bc3da818c16762ed"
+
+	inference, err := roles.CreateImpliedRole(identityClient, priorRoleID, impliedRoleID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Explain the Effective Roles of a User in a Project
+
+	rules, err := roles.ListRoleInferenceRules(identityClient).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	allPages, err := roles.List(identityClient, nil).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allRoles, err := roles.ExtractRoles(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	// assignedRoleIDs are the roles of the user in the project, as listed
+	// with roles.ListAssignments.
+	effectiveRoles := roles.ResolveEffectiveRoles(assignedRoleIDs, roles.ResolveOpts{
+		Rules: rules,
+		Roles: allRoles,
+	})
+
+	for _, role := range effectiveRoles {
+		if role.Assigned() {
+			fmt.Printf("%s: assigned\n", role.Name)
+			continue
+		}
+		var chain []string
+		for _, prior := range role.ImpliedBy {
+			chain = append(chain, prior.Name)
+		}
+		fmt.Printf("%s: implied by %s\n", role.Name, strings.Join(chain, " -> "))
+	}
 */
 package roles
@@ -0,0 +1,101 @@
+package roles
+
+// ResolveOpts holds what ResolveEffectiveRoles expands assigned roles with.
+// They are usually the results of ListRoleInferenceRules and List.
+type ResolveOpts struct {
+	// Rules are the role inference rules.
+	Rules []ImpliedRoles
+
+	// Roles are the known roles. They tell which roles are domain-specific,
+	// and name the roles the rules don't. The roles missing from Roles are
+	// considered global.
+	Roles []Role
+}
+
+// EffectiveRole is a role a user effectively has, along with the reason why.
+type EffectiveRole struct {
+	// ID is the unique ID of the role.
+	ID string
+
+	// Name is the role name, if known.
+	Name string
+
+	// ImpliedBy is the chain of roles implying the role, from an assigned
+	// role down to the role directly implying it. It is empty for an
+	// assigned role. When the role is implied in several ways, it is one of
+	// the shortest chains.
+	ImpliedBy []RoleReference
+}
+
+// Assigned reports whether the role is assigned rather than implied.
+func (r EffectiveRole) Assigned() bool {
+	return len(r.ImpliedBy) == 0
+}
+
+/*
+ResolveEffectiveRoles expands the IDs of the roles assigned to a user into the
+roles the user effectively has, without a request to Keystone. The roles are
+expanded the way Keystone does when issuing a token:
+
+Each role implied by an effective role, according to the rules, is also
+effective, however deep the chain of implications is. Cycles in the rules are
+harmless.
+
+Domain-specific roles aren't effective, though the roles they imply are.
+
+The assigned roles come first in the result, in the order given, followed by
+the implied roles, closest first. Each role appears once.
+*/
+func ResolveEffectiveRoles(assignedRoleIDs []string, opts ResolveOpts) []EffectiveRole {
+	names := make(map[string]string)
+	implies := make(map[string][]string)
+	for _, rule := range opts.Rules {
+		names[rule.PriorRole.ID] = rule.PriorRole.Name
+		for _, implied := range rule.Implies {
+			names[implied.ID] = implied.Name
+			implies[rule.PriorRole.ID] = append(implies[rule.PriorRole.ID], implied.ID)
+		}
+	}
+	domainSpecific := make(map[string]bool)
+	for _, role := range opts.Roles {
+		names[role.ID] = role.Name
+		domainSpecific[role.ID] = role.DomainID != ""
+	}
+
+	type pending struct {
+		id        string
+		impliedBy []RoleReference
+	}
+	var queue []pending
+	seen := make(map[string]bool)
+	for _, id := range assignedRoleIDs {
+		if !seen[id] {
+			seen[id] = true
+			queue = append(queue, pending{id: id})
+		}
+	}
+
+	var effective []EffectiveRole
+	for i := 0; i < len(queue); i++ {
+		p := queue[i]
+		if !domainSpecific[p.id] {
+			effective = append(effective, EffectiveRole{
+				ID:        p.id,
+				Name:      names[p.id],
+				ImpliedBy: p.impliedBy,
+			})
+		}
+
+		for _, id := range implies[p.id] {
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+			impliedBy := make([]RoleReference, len(p.impliedBy), len(p.impliedBy)+1)
+			copy(impliedBy, p.impliedBy)
+			impliedBy = append(impliedBy, RoleReference{ID: p.id, Name: names[p.id]})
+			queue = append(queue, pending{id: id, impliedBy: impliedBy})
+		}
+	}
+	return effective
+}
@@ -212,3 +212,103 @@ type AssignmentResult struct {
 type UnassignmentResult struct {
 	gophercloud.ErrResult
 }
+
+// RoleReference identifies a role in a role inference rule.
+type RoleReference struct {
+	// ID is the unique ID of the role.
+	ID string `json:"id"`
+
+	// Name is the role name.
+	Name string `json:"name"`
+
+	// Links contains referencing links to the role.
+	Links map[string]interface{} `json:"links"`
+}
+
+// RoleInference is a role inference rule: a user assigned the prior role
+// also effectively has the implied role.
+type RoleInference struct {
+	// PriorRole is the role implying the other.
+	PriorRole RoleReference `json:"prior_role"`
+
+	// Implies is the implied role.
+	Implies RoleReference `json:"implies"`
+}
+
+// ImpliedRoles are the role inference rules of a prior role.
+type ImpliedRoles struct {
+	// PriorRole is the role implying the others.
+	PriorRole RoleReference `json:"prior_role"`
+
+	// Implies are the roles directly implied by PriorRole.
+	Implies []RoleReference `json:"implies"`
+}
+
+type roleInferenceResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets any roleInferenceResult as a RoleInference.
+func (r roleInferenceResult) Extract() (*RoleInference, error) {
+	var s struct {
+		RoleInference *RoleInference `json:"role_inference"`
+	}
+	err := r.ExtractInto(&s)
+	return s.RoleInference, err
+}
+
+// CreateImpliedRoleResult is the result of a CreateImpliedRole request. Call
+// its Extract method to interpret it as a RoleInference.
+type CreateImpliedRoleResult struct {
+	roleInferenceResult
+}
+
+// GetImpliedRoleResult is the result of a GetImpliedRole request. Call its
+// Extract method to interpret it as a RoleInference.
+type GetImpliedRoleResult struct {
+	roleInferenceResult
+}
+
+// CheckImpliedRoleResult is the result of a CheckImpliedRole request. Call its
+// ExtractErr method to determine if the prior role implies the other.
+type CheckImpliedRoleResult struct {
+	gophercloud.ErrResult
+}
+
+// DeleteImpliedRoleResult is the result of a DeleteImpliedRole request. Call
+// its ExtractErr method to determine if the request succeeded or failed.
+type DeleteImpliedRoleResult struct {
+	gophercloud.ErrResult
+}
+
+// ListImpliedRolesResult is the result of a ListImpliedRoles request. Call its
+// Extract method to interpret it as ImpliedRoles.
+type ListImpliedRolesResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a ListImpliedRolesResult as ImpliedRoles.
+func (r ListImpliedRolesResult) Extract() (*ImpliedRoles, error) {
+	var s struct {
+		RoleInference *ImpliedRoles `json:"role_inference"`
+	}
+	err := r.ExtractInto(&s)
+	return s.RoleInference, err
+}
+
+// ListRoleInferenceRulesResult is the result of a ListRoleInferenceRules
+// request. Call its Extract method to interpret it as a slice of
+// ImpliedRoles.
+type ListRoleInferenceRulesResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a ListRoleInferenceRulesResult as a slice of
+// ImpliedRoles, one for each prior role.
+func (r ListRoleInferenceRulesResult) Extract() ([]ImpliedRoles, error) {
+	var s struct {
+		RoleInferences []ImpliedRoles `json:"role_inferences"`
+	}
+	err := r.ExtractInto(&s)
+	return s.RoleInferences, err
+}
@@ -387,3 +387,205 @@ func HandleListAssignmentsOnResourceSuccessfully(t *testing.T) {
 	th.Mux.HandleFunc("/domains/{domain_id}/users/{user_id}/roles", fn)
 	th.Mux.HandleFunc("/domains/{domain_id}/groups/{group_id}/roles", fn)
 }
+
+// RoleInferenceOutput provides the result of a CreateImpliedRole or
+// GetImpliedRole request.
+const RoleInferenceOutput = `
+{
+    "role_inference": {
+        "prior_role": {
+            "id": "7ceab6192ea34a548cc71b24f72e762c",
+            "links": {
+                "self": "http://example.com/identity/v3/roles/7ceab6192ea34a548cc71b24f72e762c"
+            },
+            "name": "admin"
+        },
+        "implies": {
+            "id": "97e2f5d38bc94842bc3da818c16762ed",
+            "links": {
+                "self": "http://example.com/identity/v3/roles/97e2f5d38bc94842bc3da818c16762ed"
+            },
+            "name": "member"
+        }
+    },
+    "links": {
+        "self": "http://example.com/identity/v3/roles/7ceab6192ea34a548cc71b24f72e762c/implies/97e2f5d38bc94842bc3da818c16762ed"
+    }
+}
+`
+
+// ListImpliedRolesOutput provides the result of a ListImpliedRoles request.
+const ListImpliedRolesOutput = `
+{
+    "role_inference": {
+        "prior_role": {
+            "id": "7ceab6192ea34a548cc71b24f72e762c",
+            "links": {
+                "self": "http://example.com/identity/v3/roles/7ceab6192ea34a548cc71b24f72e762c"
+            },
+            "name": "admin"
+        },
+        "implies": [
+            {
+                "id": "97e2f5d38bc94842bc3da818c16762ed",
+                "links": {
+                    "self": "http://example.com/identity/v3/roles/97e2f5d38bc94842bc3da818c16762ed"
+                },
+                "name": "member"
+            }
+        ]
+    },
+    "links": {
+        "self": "http://example.com/identity/v3/roles/7ceab6192ea34a548cc71b24f72e762c/implies"
+    }
+}
+`
+
+// ListRoleInferenceRulesOutput provides the result of a
+// ListRoleInferenceRules request.
+const ListRoleInferenceRulesOutput = `
+{
+    "role_inferences": [
+        {
+            "prior_role": {
+                "id": "7ceab6192ea34a548cc71b24f72e762c",
+                "links": {
+                    "self": "http://example.com/identity/v3/roles/7ceab6192ea34a548cc71b24f72e762c"
+                },
+                "name": "admin"
+            },
+            "implies": [
+                {
+                    "id": "97e2f5d38bc94842bc3da818c16762ed",
+                    "links": {
+                        "self": "http://example.com/identity/v3/roles/97e2f5d38bc94842bc3da818c16762ed"
+                    },
+                    "name": "member"
+                }
+            ]
+        },
+        {
+            "prior_role": {
+                "id": "97e2f5d38bc94842bc3da818c16762ed",
+                "links": {
+                    "self": "http://example.com/identity/v3/roles/97e2f5d38bc94842bc3da818c16762ed"
+                },
+                "name": "member"
+            },
+            "implies": [
+                {
+                    "id": "2844b2a08be147a08ef58317d6471f1f",
+                    "links": {
+                        "self": "http://example.com/identity/v3/roles/2844b2a08be147a08ef58317d6471f1f"
+                    },
+                    "name": "reader"
+                }
+            ]
+        }
+    ],
+    "links": {
+        "self": "http://example.com/identity/v3/role_inference_rules"
+    }
+}
+`
+
+// AdminRoleReference is the prior role of the role inference rules.
+var AdminRoleReference = roles.RoleReference{
+	ID:   "7ceab6192ea34a548cc71b24f72e762c",
+	Name: "admin",
+	Links: map[string]interface{}{
+		"self": "http://example.com/identity/v3/roles/7ceab6192ea34a548cc71b24f72e762c",
+	},
+}
+
+// MemberRoleReference is the role implied by AdminRoleReference.
+var MemberRoleReference = roles.RoleReference{
+	ID:   "97e2f5d38bc94842bc3da818c16762ed",
+	Name: "member",
+	Links: map[string]interface{}{
+		"self": "http://example.com/identity/v3/roles/97e2f5d38bc94842bc3da818c16762ed",
+	},
+}
+
+// ReaderRoleReference is the role implied by MemberRoleReference.
+var ReaderRoleReference = roles.RoleReference{
+	ID:   "2844b2a08be147a08ef58317d6471f1f",
+	Name: "reader",
+	Links: map[string]interface{}{
+		"self": "http://example.com/identity/v3/roles/2844b2a08be147a08ef58317d6471f1f",
+	},
+}
+
+// ExpectedRoleInference is the role inference rule of RoleInferenceOutput.
+var ExpectedRoleInference = roles.RoleInference{
+	PriorRole: AdminRoleReference,
+	Implies:   MemberRoleReference,
+}
+
+// ExpectedRoleInferenceRules are the role inference rules of
+// ListRoleInferenceRulesOutput.
+var ExpectedRoleInferenceRules = []roles.ImpliedRoles{
+	{
+		PriorRole: AdminRoleReference,
+		Implies:   []roles.RoleReference{MemberRoleReference},
+	},
+	{
+		PriorRole: MemberRoleReference,
+		Implies:   []roles.RoleReference{ReaderRoleReference},
+	},
+}
+
+// HandleImpliedRoleSuccessfully creates an HTTP handler at
+// `/roles/7ceab6192ea34a548cc71b24f72e762c/implies/97e2f5d38bc94842bc3da818c16762ed`
+// on the test handler mux that creates, gets, checks and deletes a role
+// inference rule.
+func HandleImpliedRoleSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/roles/7ceab6192ea34a548cc71b24f72e762c/implies/97e2f5d38bc94842bc3da818c16762ed", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		switch r.Method {
+		case "PUT":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, RoleInferenceOutput)
+		case "GET":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, RoleInferenceOutput)
+		case "HEAD", "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+}
+
+// HandleListImpliedRolesSuccessfully creates an HTTP handler at
+// `/roles/7ceab6192ea34a548cc71b24f72e762c/implies` on the test handler mux
+// that responds with the roles implied by the admin role.
+func HandleListImpliedRolesSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/roles/7ceab6192ea34a548cc71b24f72e762c/implies", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListImpliedRolesOutput)
+	})
+}
+
+// HandleListRoleInferenceRulesSuccessfully creates an HTTP handler at
+// `/role_inference_rules` on the test handler mux that responds with the
+// rules of two prior roles.
+func HandleListRoleInferenceRulesSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/role_inference_rules", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListRoleInferenceRulesOutput)
+	})
+}
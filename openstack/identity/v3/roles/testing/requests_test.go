@@ -276,3 +276,61 @@ func TestUnassign(t *testing.T) {
 	}).ExtractErr()
 	th.AssertNoErr(t, err)
 }
+
+func TestCreateImpliedRole(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleImpliedRoleSuccessfully(t)
+
+	actual, err := roles.CreateImpliedRole(client.ServiceClient(), "7ceab6192ea34a548cc71b24f72e762c", "97e2f5d38bc94842bc3da818c16762ed").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedRoleInference, *actual)
+}
+
+func TestGetImpliedRole(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleImpliedRoleSuccessfully(t)
+
+	actual, err := roles.GetImpliedRole(client.ServiceClient(), "7ceab6192ea34a548cc71b24f72e762c", "97e2f5d38bc94842bc3da818c16762ed").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedRoleInference, *actual)
+}
+
+func TestCheckImpliedRole(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleImpliedRoleSuccessfully(t)
+
+	err := roles.CheckImpliedRole(client.ServiceClient(), "7ceab6192ea34a548cc71b24f72e762c", "97e2f5d38bc94842bc3da818c16762ed").ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestDeleteImpliedRole(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleImpliedRoleSuccessfully(t)
+
+	err := roles.DeleteImpliedRole(client.ServiceClient(), "7ceab6192ea34a548cc71b24f72e762c", "97e2f5d38bc94842bc3da818c16762ed").ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestListImpliedRoles(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListImpliedRolesSuccessfully(t)
+
+	actual, err := roles.ListImpliedRoles(client.ServiceClient(), "7ceab6192ea34a548cc71b24f72e762c").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedRoleInferenceRules[0], *actual)
+}
+
+func TestListRoleInferenceRules(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListRoleInferenceRulesSuccessfully(t)
+
+	actual, err := roles.ListRoleInferenceRules(client.ServiceClient()).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedRoleInferenceRules, actual)
+}
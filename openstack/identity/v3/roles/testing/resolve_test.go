@@ -0,0 +1,67 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/roles"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestResolveEffectiveRoles(t *testing.T) {
+	opts := roles.ResolveOpts{Rules: ExpectedRoleInferenceRules}
+
+	admin := roles.RoleReference{ID: AdminRoleReference.ID, Name: "admin"}
+	member := roles.RoleReference{ID: MemberRoleReference.ID, Name: "member"}
+
+	actual := roles.ResolveEffectiveRoles([]string{AdminRoleReference.ID}, opts)
+	th.CheckDeepEquals(t, []roles.EffectiveRole{
+		{ID: AdminRoleReference.ID, Name: "admin"},
+		{ID: MemberRoleReference.ID, Name: "member", ImpliedBy: []roles.RoleReference{admin}},
+		{ID: ReaderRoleReference.ID, Name: "reader", ImpliedBy: []roles.RoleReference{admin, member}},
+	}, actual)
+	th.CheckEquals(t, true, actual[0].Assigned())
+	th.CheckEquals(t, false, actual[2].Assigned())
+
+	// An assigned role isn't explained by the other assigned roles, and the
+	// roles unknown to the rules are kept.
+	actual = roles.ResolveEffectiveRoles([]string{"custom", ReaderRoleReference.ID, MemberRoleReference.ID, ReaderRoleReference.ID}, opts)
+	th.CheckDeepEquals(t, []roles.EffectiveRole{
+		{ID: "custom"},
+		{ID: ReaderRoleReference.ID, Name: "reader"},
+		{ID: MemberRoleReference.ID, Name: "member"},
+	}, actual)
+}
+
+func TestResolveEffectiveRolesCycle(t *testing.T) {
+	opts := roles.ResolveOpts{
+		Rules: append(ExpectedRoleInferenceRules, roles.ImpliedRoles{
+			PriorRole: ReaderRoleReference,
+			Implies:   []roles.RoleReference{AdminRoleReference},
+		}),
+	}
+
+	actual := roles.ResolveEffectiveRoles([]string{MemberRoleReference.ID}, opts)
+	th.CheckEquals(t, 3, len(actual))
+	th.CheckEquals(t, AdminRoleReference.ID, actual[2].ID)
+	th.CheckEquals(t, 2, len(actual[2].ImpliedBy))
+}
+
+func TestResolveEffectiveRolesDomainSpecific(t *testing.T) {
+	support := roles.RoleReference{ID: SecondRole.ID, Name: SecondRole.Name}
+	opts := roles.ResolveOpts{
+		Rules: []roles.ImpliedRoles{
+			{
+				PriorRole: support,
+				Implies:   []roles.RoleReference{ReaderRoleReference},
+			},
+		},
+		Roles: []roles.Role{SecondRole, {ID: ReaderRoleReference.ID, Name: "reader"}},
+	}
+
+	// The domain-specific support role isn't effective, but explains the
+	// reader role.
+	actual := roles.ResolveEffectiveRoles([]string{SecondRole.ID}, opts)
+	th.CheckDeepEquals(t, []roles.EffectiveRole{
+		{ID: ReaderRoleReference.ID, Name: "reader", ImpliedBy: []roles.RoleReference{support}},
+	}, actual)
+}
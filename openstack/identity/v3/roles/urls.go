@@ -37,3 +37,15 @@ func listAssignmentsOnResourceURL(client *gophercloud.ServiceClient, targetType,
 func assignURL(client *gophercloud.ServiceClient, targetType, targetID, actorType, actorID, roleID string) string {
 	return client.ServiceURL(targetType, targetID, actorType, actorID, rolePath, roleID)
 }
+
+func listImpliedRolesURL(client *gophercloud.ServiceClient, priorRoleID string) string {
+	return client.ServiceURL(rolePath, priorRoleID, "implies")
+}
+
+func impliedRoleURL(client *gophercloud.ServiceClient, priorRoleID, impliedRoleID string) string {
+	return client.ServiceURL(rolePath, priorRoleID, "implies", impliedRoleID)
+}
+
+func listRoleInferenceRulesURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL("role_inference_rules")
+}
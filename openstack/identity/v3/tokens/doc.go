@@ -104,5 +104,65 @@ Example to Create a Token from a Username and Password with Project Name Scope
 		panic(err)
 	}
 
+Example to Create a System-Scoped Token and Inspect its Roles
+
+	authOptions := tokens.AuthOptions{
+		UserID:   "username",
+		Password: "password",
+		Scope:    tokens.Scope{System: true},
+	}
+
+	result := tokens.Create(identityClient, &authOptions)
+	isSystem, err := result.ExtractIsSystemScoped()
+	if err != nil {
+		panic(err)
+	}
+
+	roles, err := result.ExtractRoles()
+	if err != nil {
+		panic(err)
+	}
+
+	for _, role := range roles {
+		if isSystem && role.Name == "admin" {
+			fmt.Println("the token can manage services and endpoints")
+		}
+	}
+
+Example to Create a Token with a Password and a TOTP Passcode
+
+	authOptions := tokens.AuthOptions{
+		UserID:   "username",
+		Password: "password",
+		Passcode: "123456",
+	}
+
+	token, err = tokens.Create(identityClient, &authOptions).ExtractToken()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Complete Multi-Factor Authentication with a Receipt
+
+	authOptions := tokens.AuthOptions{
+		UserID:   "username",
+		Password: "password",
+	}
+
+	token, err := tokens.Create(identityClient, &authOptions).ExtractToken()
+	if receiptErr, ok := err.(tokens.ErrAuthReceiptRequired); ok {
+		// receiptErr.Receipt.MissingMethods() tells which credentials to ask
+		// the user for, e.g. [["totp"]].
+		authOptions = tokens.AuthOptions{
+			UserID:   "username",
+			Passcode: "123456",
+			Receipt:  receiptErr.Receipt.ID,
+		}
+
+		token, err = tokens.Create(identityClient, &authOptions).ExtractToken()
+	}
+	if err != nil {
+		panic(err)
+	}
 */
 package tokens
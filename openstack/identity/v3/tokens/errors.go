@@ -0,0 +1,49 @@
+package tokens
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// ErrAuthReceiptRequired is returned by Create when Keystone accepted some of
+// the authentication methods provided, but requires additional ones for the
+// user, as is the case for multi-factor authentication. Authentication can be
+// completed by calling Create again with AuthOptions.Receipt set to
+// Receipt.ID and the credentials of the missing methods.
+type ErrAuthReceiptRequired struct {
+	gophercloud.ErrDefault401
+	Receipt Receipt
+}
+
+func (e ErrAuthReceiptRequired) Error() string {
+	return fmt.Sprintf("Additional authentication methods are required: %v", e.Receipt.MissingMethods())
+}
+
+// checkReceipt converts err into an ErrAuthReceiptRequired if resp carries
+// an authentication receipt.
+func checkReceipt(resp *http.Response, err error) error {
+	id := resp.Header.Get("Openstack-Auth-Receipt")
+	if resp.StatusCode != http.StatusUnauthorized || id == "" {
+		return err
+	}
+
+	e, ok := err.(gophercloud.ErrDefault401)
+	if !ok {
+		return err
+	}
+
+	var s struct {
+		Receipt             Receipt    `json:"receipt"`
+		RequiredAuthMethods [][]string `json:"required_auth_methods"`
+	}
+	if jsonErr := json.Unmarshal(e.Body, &s); jsonErr != nil {
+		return err
+	}
+
+	s.Receipt.ID = id
+	s.Receipt.RequiredMethods = s.RequiredAuthMethods
+	return ErrAuthReceiptRequired{ErrDefault401: e, Receipt: s.Receipt}
+}
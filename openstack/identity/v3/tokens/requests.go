@@ -2,12 +2,17 @@ package tokens
 
 import "github.com/gophercloud/gophercloud"
 
-// Scope allows a created token to be limited to a specific domain or project.
+// Scope allows a created token to be limited to a specific domain or project,
+// or to the whole deployment.
 type Scope struct {
 	ProjectID   string
 	ProjectName string
 	DomainID    string
 	DomainName  string
+
+	// System scopes the token to the whole deployment. It must be supplied
+	// alone.
+	System bool
 }
 
 // AuthOptionsBuilder provides the ability for extensions to add additional
@@ -20,6 +25,34 @@ type AuthOptionsBuilder interface {
 	CanReauth() bool
 }
 
+// AuthOptionsHeadersBuilder may be implemented by an AuthOptionsBuilder to
+// send additional headers with the Create request, such as an authentication
+// receipt.
+type AuthOptionsHeadersBuilder interface {
+	ToTokenV3HeadersMap() (map[string]string, error)
+}
+
+// AuthMethod is implemented by the AuthOptionsBuilders of extensions which
+// obtain a token otherwise than with a single Create request, such as
+// federated authentication. openstack.AuthenticateV3 relies on it to
+// authenticate with them.
+type AuthMethod interface {
+	AuthOptionsBuilder
+
+	// CreateToken obtains a token from the identity service of client.
+	CreateToken(client *gophercloud.ServiceClient) CreateResult
+
+	// TokenCacheRequest stands for the authentication request in the key of
+	// a token cache, given the scope built by ToTokenV3ScopeMap. It must
+	// identify the user, credentials and scope of the token, but hold no
+	// secret in clear text.
+	TokenCacheRequest(scope map[string]interface{}) map[string]interface{}
+
+	// WithoutReauth returns a copy of the options with reauthentication
+	// disallowed, with which the client authenticates again.
+	WithoutReauth() AuthOptionsBuilder
+}
+
 // AuthOptions represents options for authenticating a user.
 type AuthOptions struct {
 	// IdentityEndpoint specifies the HTTP endpoint that is required to work with
@@ -53,6 +86,22 @@ type AuthOptions struct {
 	TokenID string `json:"-"`
 
 	Scope Scope `json:"-"`
+
+	// ApplicationCredentialID or ApplicationCredentialName, together with
+	// ApplicationCredentialSecret, authenticate with an application credential.
+	// A name must be accompanied by either UserID or Username and DomainID or
+	// DomainName.
+	ApplicationCredentialID     string `json:"-"`
+	ApplicationCredentialName   string `json:"-"`
+	ApplicationCredentialSecret string `json:"-"`
+
+	// Passcode is a time-based one-time password for the "totp" method. It may
+	// be combined with Password for multi-factor authentication.
+	Passcode string `json:"-"`
+
+	// Receipt is the ID of the authentication receipt returned by a previous
+	// Create request that failed with ErrAuthReceiptRequired.
+	Receipt string `json:"-"`
 }
 
 // ToTokenV3CreateMap builds a request body from AuthOptions.
@@ -65,6 +114,12 @@ func (opts *AuthOptions) ToTokenV3CreateMap(scope map[string]interface{}) (map[s
 		DomainName:  opts.DomainName,
 		AllowReauth: opts.AllowReauth,
 		TokenID:     opts.TokenID,
+
+		ApplicationCredentialID:     opts.ApplicationCredentialID,
+		ApplicationCredentialName:   opts.ApplicationCredentialName,
+		ApplicationCredentialSecret: opts.ApplicationCredentialSecret,
+
+		Passcode: opts.Passcode,
 	}
 
 	return gophercloudAuthOpts.ToTokenV3CreateMap(scope)
@@ -78,11 +133,24 @@ func (opts *AuthOptions) ToTokenV3ScopeMap() (map[string]interface{}, error) {
 		Scope:      &scope,
 		DomainID:   opts.DomainID,
 		DomainName: opts.DomainName,
+
+		ApplicationCredentialID:   opts.ApplicationCredentialID,
+		ApplicationCredentialName: opts.ApplicationCredentialName,
 	}
 
 	return gophercloudAuthOpts.ToTokenV3ScopeMap()
 }
 
+// ToTokenV3HeadersMap builds the headers of a Create request from AuthOptions.
+// It implements AuthOptionsHeadersBuilder.
+func (opts *AuthOptions) ToTokenV3HeadersMap() (map[string]string, error) {
+	gophercloudAuthOpts := gophercloud.AuthOptions{
+		Receipt: opts.Receipt,
+	}
+
+	return gophercloudAuthOpts.ToTokenV3HeadersMap()
+}
+
 func (opts *AuthOptions) CanReauth() bool {
 	return opts.AllowReauth
 }
@@ -95,6 +163,10 @@ func subjectTokenHeaders(c *gophercloud.ServiceClient, subjectToken string) map[
 
 // Create authenticates and either generates a new token, or changes the Scope
 // of an existing token.
+//
+// If Keystone requires more authentication methods than were provided, the
+// error is an ErrAuthReceiptRequired. Authentication can then be completed by
+// calling Create again with the receipt and the missing credentials.
 func Create(c *gophercloud.ServiceClient, opts AuthOptionsBuilder) (r CreateResult) {
 	scope, err := opts.ToTokenV3ScopeMap()
 	if err != nil {
@@ -108,12 +180,38 @@ func Create(c *gophercloud.ServiceClient, opts AuthOptionsBuilder) (r CreateResu
 		return
 	}
 
+	h := make(map[string]string)
+	if hb, ok := opts.(AuthOptionsHeadersBuilder); ok {
+		h, err = hb.ToTokenV3HeadersMap()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		if h == nil {
+			h = make(map[string]string)
+		}
+	}
+	h["X-Auth-Token"] = ""
+
+	// A 401 response must not trigger reauthentication, which would hide an
+	// authentication receipt and needlessly renew the token of c.
+	if c.ProviderClient.ReauthFunc != nil {
+		provider := *c.ProviderClient
+		provider.ReauthFunc = nil
+		client := *c
+		client.ProviderClient = &provider
+		c = &client
+	}
+
 	resp, err := c.Post(tokenURL(c), b, &r.Body, &gophercloud.RequestOpts{
-		MoreHeaders: map[string]string{"X-Auth-Token": ""},
+		MoreHeaders: h,
 	})
 	r.Err = err
 	if resp != nil {
 		r.Header = resp.Header
+		if err != nil {
+			r.Err = checkReceipt(resp, err)
+		}
 	}
 	return
 }
@@ -146,8 +244,9 @@ func Validate(c *gophercloud.ServiceClient, token string) (bool, error) {
 
 // Revoke immediately makes specified token invalid.
 func Revoke(c *gophercloud.ServiceClient, token string) (r RevokeResult) {
-	_, r.Err = c.Delete(tokenURL(c), &gophercloud.RequestOpts{
+	resp, err := c.Delete(tokenURL(c), &gophercloud.RequestOpts{
 		MoreHeaders: subjectTokenHeaders(c, token),
 	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
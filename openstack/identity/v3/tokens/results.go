@@ -76,6 +76,13 @@ type Project struct {
 	Name   string `json:"name"`
 }
 
+// System provides information about the system to which User is authorized,
+// for a system-scoped token.
+type System struct {
+	// All is true if the token applies to the whole deployment.
+	All bool `json:"all"`
+}
+
 // commonResult is the response from a request. A commonResult has various
 // methods which can be used to extract different details about the result.
 type commonResult struct {
@@ -102,6 +109,22 @@ func (r commonResult) ExtractToken() (*Token, error) {
 	return &s, err
 }
 
+// ExtractTokenID implements the gophercloud.AuthResult interface. The returned
+// string is the same as the ID field of the Token struct returned from
+// ExtractToken().
+func (r CreateResult) ExtractTokenID() (string, error) {
+	return r.Header.Get("X-Subject-Token"), r.Err
+}
+
+// ExtractTokenExpiresAt implements the gophercloud.AuthResult interface.
+func (r CreateResult) ExtractTokenExpiresAt() (time.Time, error) {
+	token, err := r.ExtractToken()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return token.ExpiresAt, nil
+}
+
 // ExtractServiceCatalog returns the ServiceCatalog that was generated along
 // with the user's Token.
 func (r commonResult) ExtractServiceCatalog() (*ServiceCatalog, error) {
@@ -137,6 +160,54 @@ func (r commonResult) ExtractProject() (*Project, error) {
 	return s.Project, err
 }
 
+// ExtractDomain returns Domain to which User is authorized, for a
+// domain-scoped token. It is nil for other tokens.
+func (r commonResult) ExtractDomain() (*Domain, error) {
+	var s struct {
+		Domain *Domain `json:"domain"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Domain, err
+}
+
+// ExtractSystem returns System to which User is authorized, for a
+// system-scoped token. It is nil for other tokens.
+func (r commonResult) ExtractSystem() (*System, error) {
+	var s struct {
+		System *System `json:"system"`
+	}
+	err := r.ExtractInto(&s)
+	return s.System, err
+}
+
+// ExtractIsSystemScoped reports whether the token is scoped to the whole
+// deployment.
+func (r commonResult) ExtractIsSystemScoped() (bool, error) {
+	s, err := r.ExtractSystem()
+	return s != nil && s.All, err
+}
+
+// ExtractMethods returns the authentication methods used to obtain the
+// token, e.g. "password" or "token".
+func (r commonResult) ExtractMethods() ([]string, error) {
+	var s struct {
+		Methods []string `json:"methods"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Methods, err
+}
+
+// ExtractAuditIDs returns the audit IDs of the token. The first one
+// identifies the token, and the second one, if any, the token it was obtained
+// from by rescoping.
+func (r commonResult) ExtractAuditIDs() ([]string, error) {
+	var s struct {
+		AuditIDs []string `json:"audit_ids"`
+	}
+	err := r.ExtractInto(&s)
+	return s.AuditIDs, err
+}
+
 // CreateResult is the response from a Create request. Use ExtractToken()
 // to interpret it as a Token, or ExtractServiceCatalog() to interpret it
 // as a service catalog.
@@ -166,6 +237,50 @@ type Token struct {
 	ExpiresAt time.Time `json:"expires_at"`
 }
 
+// Receipt is issued by Keystone when authentication succeeded with some, but
+// not all, of the methods required for a user. It is carried by an
+// ErrAuthReceiptRequired.
+type Receipt struct {
+	// ID is the receipt to send back, as AuthOptions.Receipt, along with the
+	// missing credentials.
+	ID string `json:"-"`
+
+	// ExpiresAt is the timestamp at which this receipt will no longer be
+	// accepted.
+	ExpiresAt time.Time `json:"expires_at"`
+
+	// Methods are the authentication methods which succeeded.
+	Methods []string `json:"methods"`
+
+	// User is the user being authenticated.
+	User User `json:"user"`
+
+	// RequiredMethods lists the authentication rules of the user. Each rule
+	// is a set of methods which, together, are sufficient to authenticate.
+	RequiredMethods [][]string `json:"-"`
+}
+
+// MissingMethods returns, for each rule of RequiredMethods, the methods which
+// still have to be provided to satisfy it.
+func (r Receipt) MissingMethods() [][]string {
+	done := make(map[string]bool, len(r.Methods))
+	for _, m := range r.Methods {
+		done[m] = true
+	}
+
+	missing := make([][]string, 0, len(r.RequiredMethods))
+	for _, rule := range r.RequiredMethods {
+		var methods []string
+		for _, m := range rule {
+			if !done[m] {
+				methods = append(methods, m)
+			}
+		}
+		missing = append(missing, methods)
+	}
+	return missing
+}
+
 func (r commonResult) ExtractInto(v interface{}) error {
 	return r.ExtractIntoStructPtr(v, "token")
 }
@@ -110,6 +110,80 @@ const TokenOutput = `
    }
 }`
 
+// SystemTokenOutput is a sample response to a Token call with a
+// system-scoped token, rescoped from another token.
+const SystemTokenOutput = `
+{
+   "token":{
+      "methods":[
+         "token",
+         "password"
+      ],
+      "roles":[
+         {
+            "id":"434426788d5a451faf763b0e6db5aefb",
+            "name":"admin"
+         },
+         {
+            "id":"9fe2ff9ee4384b1894a90878d3e92bab",
+            "name":"reader"
+         }
+      ],
+      "expires_at":"2017-06-03T02:19:49.000000Z",
+      "system":{
+         "all":true
+      },
+      "user":{
+         "domain":{
+            "id":"default",
+            "name":"Default"
+         },
+         "password_expires_at":null,
+         "name":"admin",
+         "id":"0fe36e73809d46aeae6705c39077b1b3"
+      },
+      "audit_ids":[
+         "3T2dc1CGQxyJsHdDu1xkcw",
+         "ysSI0bEWR0Gmrp4LHL9LFw"
+      ],
+      "issued_at":"2017-06-03T01:19:49.000000Z"
+   }
+}`
+
+// DomainTokenOutput is a sample response to a Token call with a
+// domain-scoped token.
+const DomainTokenOutput = `
+{
+   "token":{
+      "methods":[
+         "password"
+      ],
+      "roles":[
+         {
+            "id":"434426788d5a451faf763b0e6db5aefb",
+            "name":"admin"
+         }
+      ],
+      "expires_at":"2017-06-03T02:19:49.000000Z",
+      "domain":{
+         "id":"default",
+         "name":"Default"
+      },
+      "user":{
+         "domain":{
+            "id":"default",
+            "name":"Default"
+         },
+         "name":"admin",
+         "id":"0fe36e73809d46aeae6705c39077b1b3"
+      },
+      "audit_ids":[
+         "qNUTIJntTzO1-XUk5STybw"
+      ],
+      "issued_at":"2017-06-03T01:19:49.000000Z"
+   }
+}`
+
 var expectedTokenTime, _ = time.Parse(gophercloud.RFC3339Milli,
 	"2017-06-03T02:19:49.000000Z")
 var ExpectedToken = tokens.Token{
@@ -207,11 +281,15 @@ var ExpectedProject = tokens.Project{
 }
 
 func getGetResult(t *testing.T) tokens.GetResult {
+	return getGetResultFrom(t, TokenOutput)
+}
+
+func getGetResultFrom(t *testing.T, output string) tokens.GetResult {
 	result := tokens.GetResult{}
 	result.Header = http.Header{
 		"X-Subject-Token": []string{testTokenID},
 	}
-	err := json.Unmarshal([]byte(TokenOutput), &result.Body)
+	err := json.Unmarshal([]byte(output), &result.Body)
 	testhelper.AssertNoErr(t, err)
 	return result
 }
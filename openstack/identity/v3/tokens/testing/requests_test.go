@@ -144,6 +144,286 @@ func TestCreateTokenID(t *testing.T) {
 	`)
 }
 
+func TestCreateAppCredIDAndSecret(t *testing.T) {
+	authTokenPost(t, tokens.AuthOptions{ApplicationCredentialID: "12345abcdef", ApplicationCredentialSecret: "mysecret"}, nil, `
+		{
+			"auth": {
+				"identity": {
+					"application_credential": {
+						"id": "12345abcdef",
+						"secret": "mysecret"
+					},
+					"methods": [
+						"application_credential"
+					]
+				}
+			}
+		}
+	`)
+}
+
+func TestCreateAppCredNameAndSecret(t *testing.T) {
+	authTokenPost(t, tokens.AuthOptions{ApplicationCredentialName: "myappcred", ApplicationCredentialSecret: "mysecret", Username: "fenris", DomainName: "default"}, nil, `
+		{
+			"auth": {
+				"identity": {
+					"application_credential": {
+						"name": "myappcred",
+						"secret": "mysecret",
+						"user": {
+							"name": "fenris",
+							"domain": {
+								"name": "default"
+							}
+						}
+					},
+					"methods": [
+						"application_credential"
+					]
+				}
+			}
+		}
+	`)
+}
+
+func TestCreateAppCredIgnoresScope(t *testing.T) {
+	authTokenPost(t, tokens.AuthOptions{ApplicationCredentialID: "12345abcdef", ApplicationCredentialSecret: "mysecret"}, &tokens.Scope{ProjectID: "123456"}, `
+		{
+			"auth": {
+				"identity": {
+					"application_credential": {
+						"id": "12345abcdef",
+						"secret": "mysecret"
+					},
+					"methods": [
+						"application_credential"
+					]
+				}
+			}
+		}
+	`)
+}
+
+func TestCreateUserIDAndPasscode(t *testing.T) {
+	authTokenPost(t, tokens.AuthOptions{UserID: "me", Passcode: "123456"}, nil, `
+		{
+			"auth": {
+				"identity": {
+					"methods": ["totp"],
+					"totp": {
+						"user": { "id": "me", "passcode": "123456" }
+					}
+				}
+			}
+		}
+	`)
+}
+
+func TestCreateUsernameDomainNamePasswordAndPasscode(t *testing.T) {
+	authTokenPost(t, tokens.AuthOptions{Username: "fakey", Password: "notpassword", Passcode: "123456", DomainName: "spork.net"}, nil, `
+		{
+			"auth": {
+				"identity": {
+					"methods": ["password", "totp"],
+					"password": {
+						"user": {
+							"domain": { "name": "spork.net" },
+							"name": "fakey",
+							"password": "notpassword"
+						}
+					},
+					"totp": {
+						"user": {
+							"domain": { "name": "spork.net" },
+							"name": "fakey",
+							"passcode": "123456"
+						}
+					}
+				}
+			}
+		}
+	`)
+}
+
+func TestCreateWithReceipt(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+
+	client := gophercloud.ServiceClient{
+		ProviderClient: &gophercloud.ProviderClient{},
+		Endpoint:       testhelper.Endpoint(),
+	}
+
+	testhelper.Mux.HandleFunc("/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		testhelper.TestMethod(t, r, "POST")
+		testhelper.TestHeader(t, r, "Openstack-Auth-Receipt", "receipt-id")
+		testhelper.TestJSONRequest(t, r, `
+			{
+				"auth": {
+					"identity": {
+						"methods": ["totp"],
+						"totp": {
+							"user": { "id": "me", "passcode": "123456" }
+						}
+					}
+				}
+			}
+		`)
+
+		w.Header().Add("X-Subject-Token", "aaa111")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{
+			"token": {
+				"expires_at": "2014-10-02T13:45:00.000000Z"
+			}
+		}`)
+	})
+
+	options := tokens.AuthOptions{UserID: "me", Passcode: "123456", Receipt: "receipt-id"}
+	token, err := tokens.Create(&client, &options).Extract()
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckEquals(t, "aaa111", token.ID)
+}
+
+// bodyOnlyAuthOptions is an AuthOptionsBuilder which doesn't implement
+// AuthOptionsHeadersBuilder.
+type bodyOnlyAuthOptions struct{}
+
+func (opts bodyOnlyAuthOptions) ToTokenV3CreateMap(map[string]interface{}) (map[string]interface{}, error) {
+	return map[string]interface{}{
+		"auth": map[string]interface{}{
+			"identity": map[string]interface{}{
+				"methods": []string{"token"},
+				"token":   map[string]interface{}{"id": "abcdef"},
+			},
+		},
+	}, nil
+}
+
+func (opts bodyOnlyAuthOptions) ToTokenV3ScopeMap() (map[string]interface{}, error) {
+	return nil, nil
+}
+
+func (opts bodyOnlyAuthOptions) CanReauth() bool {
+	return false
+}
+
+func TestCreateWithoutHeadersBuilder(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+
+	client := gophercloud.ServiceClient{
+		ProviderClient: &gophercloud.ProviderClient{},
+		Endpoint:       testhelper.Endpoint(),
+	}
+
+	testhelper.Mux.HandleFunc("/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		testhelper.TestMethod(t, r, "POST")
+		testhelper.TestHeader(t, r, "Openstack-Auth-Receipt", "")
+		testhelper.TestJSONRequest(t, r, `
+			{
+				"auth": {
+					"identity": {
+						"methods": ["token"],
+						"token": { "id": "abcdef" }
+					}
+				}
+			}
+		`)
+
+		w.Header().Add("X-Subject-Token", "aaa111")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{
+			"token": {
+				"expires_at": "2014-10-02T13:45:00.000000Z"
+			}
+		}`)
+	})
+
+	token, err := tokens.Create(&client, bodyOnlyAuthOptions{}).Extract()
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckEquals(t, "aaa111", token.ID)
+}
+
+// handleReceiptRequired responds to a token creation with an authentication
+// receipt, as Keystone does when multi-factor authentication is required.
+func handleReceiptRequired(t *testing.T) {
+	testhelper.Mux.HandleFunc("/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		testhelper.TestMethod(t, r, "POST")
+
+		w.Header().Add("Openstack-Auth-Receipt", "receipt-id")
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprintf(w, `{
+			"receipt": {
+				"expires_at": "2018-07-05T11:21:33.000000Z",
+				"issued_at": "2018-07-05T11:16:33.000000Z",
+				"methods": ["password"],
+				"user": {
+					"domain": { "id": "default", "name": "Default" },
+					"id": "me",
+					"name": "fakey"
+				}
+			},
+			"required_auth_methods": [
+				["password", "totp"],
+				["password", "custom-auth-method"]
+			]
+		}`)
+	})
+}
+
+func TestCreateReceiptRequired(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+
+	client := gophercloud.ServiceClient{
+		ProviderClient: &gophercloud.ProviderClient{},
+		Endpoint:       testhelper.Endpoint(),
+	}
+
+	handleReceiptRequired(t)
+
+	options := tokens.AuthOptions{UserID: "me", Password: "squirrel!"}
+	_, err := tokens.Create(&client, &options).Extract()
+	receiptErr, ok := err.(tokens.ErrAuthReceiptRequired)
+	if !ok {
+		t.Fatalf("expected ErrAuthReceiptRequired, got %T: %v", err, err)
+	}
+
+	receipt := receiptErr.Receipt
+	testhelper.CheckEquals(t, "receipt-id", receipt.ID)
+	testhelper.CheckEquals(t, "me", receipt.User.ID)
+	testhelper.CheckEquals(t, time.Date(2018, 7, 5, 11, 21, 33, 0, time.UTC), receipt.ExpiresAt)
+	testhelper.CheckDeepEquals(t, []string{"password"}, receipt.Methods)
+	testhelper.CheckDeepEquals(t, [][]string{{"totp"}, {"custom-auth-method"}}, receipt.MissingMethods())
+}
+
+func TestCreateReceiptRequiredWithReauth(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+
+	reauths := 0
+	provider := &gophercloud.ProviderClient{}
+	provider.ReauthFunc = func() error {
+		reauths++
+		return nil
+	}
+	client := gophercloud.ServiceClient{
+		ProviderClient: provider,
+		Endpoint:       testhelper.Endpoint(),
+	}
+
+	handleReceiptRequired(t)
+
+	options := tokens.AuthOptions{UserID: "me", Password: "squirrel!"}
+	_, err := tokens.Create(&client, &options).Extract()
+	if _, ok := err.(tokens.ErrAuthReceiptRequired); !ok {
+		t.Fatalf("expected ErrAuthReceiptRequired, got %T: %v", err, err)
+	}
+	testhelper.CheckEquals(t, 0, reauths)
+}
+
 func TestCreateProjectIDScope(t *testing.T) {
 	options := tokens.AuthOptions{UserID: "fenris", Password: "g0t0h311"}
 	scope := &tokens.Scope{ProjectID: "123456"}
@@ -194,6 +474,31 @@ func TestCreateDomainIDScope(t *testing.T) {
 	`)
 }
 
+func TestCreateSystemScope(t *testing.T) {
+	options := tokens.AuthOptions{UserID: "fenris", Password: "g0t0h311"}
+	scope := &tokens.Scope{System: true}
+	authTokenPost(t, options, scope, `
+		{
+			"auth": {
+				"identity": {
+					"methods": ["password"],
+					"password": {
+						"user": {
+							"id": "fenris",
+							"password": "g0t0h311"
+						}
+					}
+				},
+				"scope": {
+					"system": {
+						"all": true
+					}
+				}
+			}
+		}
+	`)
+}
+
 func TestCreateDomainNameScope(t *testing.T) {
 	options := tokens.AuthOptions{UserID: "fenris", Password: "g0t0h311"}
 	scope := &tokens.Scope{DomainName: "evil-plans"}
@@ -376,6 +681,14 @@ func TestCreateFailureUserIDDomainName(t *testing.T) {
 	authTokenPostErr(t, options, nil, false, gophercloud.ErrDomainNameWithUserID{})
 }
 
+func TestCreateFailureAppCredMissingSecret(t *testing.T) {
+	authTokenPostErr(t, tokens.AuthOptions{ApplicationCredentialID: "12345abcdef"}, nil, false, gophercloud.ErrAppCredMissingSecret{})
+}
+
+func TestCreateFailureAppCredNameMissingUser(t *testing.T) {
+	authTokenPostErr(t, tokens.AuthOptions{ApplicationCredentialName: "myappcred", ApplicationCredentialSecret: "mysecret"}, nil, false, gophercloud.ErrUsernameOrUserID{})
+}
+
 func TestCreateFailureScopeProjectNameAlone(t *testing.T) {
 	options := tokens.AuthOptions{UserID: "myself", Password: "swordfish"}
 	scope := &tokens.Scope{ProjectName: "notenough"}
@@ -406,6 +719,18 @@ func TestCreateFailureScopeDomainIDAndDomainName(t *testing.T) {
 	authTokenPostErr(t, options, scope, false, gophercloud.ErrScopeDomainIDOrDomainName{})
 }
 
+func TestCreateFailureScopeSystemAndProjectID(t *testing.T) {
+	options := tokens.AuthOptions{UserID: "myself", Password: "swordfish"}
+	scope := &tokens.Scope{System: true, ProjectID: "toomuch"}
+	authTokenPostErr(t, options, scope, false, gophercloud.ErrScopeSystemAlone{})
+}
+
+func TestCreateFailureScopeSystemAndDomainName(t *testing.T) {
+	options := tokens.AuthOptions{UserID: "myself", Password: "swordfish"}
+	scope := &tokens.Scope{System: true, DomainName: "notneeded"}
+	authTokenPostErr(t, options, scope, false, gophercloud.ErrScopeSystemAlone{})
+}
+
 /*
 func TestCreateFailureEmptyScope(t *testing.T) {
 	options := tokens.AuthOptions{UserID: "myself", Password: "swordfish"}
@@ -3,6 +3,7 @@ package testing
 import (
 	"testing"
 
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/tokens"
 	"github.com/gophercloud/gophercloud/testhelper"
 )
 
@@ -50,3 +51,72 @@ func TestExtractProject(t *testing.T) {
 
 	testhelper.CheckDeepEquals(t, &ExpectedProject, project)
 }
+
+func TestExtractProjectScope(t *testing.T) {
+	result := getGetResult(t)
+
+	domain, err := result.ExtractDomain()
+	testhelper.AssertNoErr(t, err)
+	if domain != nil {
+		t.Errorf("Expected no domain, got %+v", domain)
+	}
+
+	system, err := result.ExtractIsSystemScoped()
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckEquals(t, false, system)
+
+	methods, err := result.ExtractMethods()
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, []string{"password"}, methods)
+
+	auditIDs, err := result.ExtractAuditIDs()
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, []string{"ysSI0bEWR0Gmrp4LHL9LFw"}, auditIDs)
+}
+
+func TestExtractDomainScope(t *testing.T) {
+	result := getGetResultFrom(t, DomainTokenOutput)
+
+	domain, err := result.ExtractDomain()
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, &tokens.Domain{ID: "default", Name: "Default"}, domain)
+
+	project, err := result.ExtractProject()
+	testhelper.AssertNoErr(t, err)
+	if project != nil {
+		t.Errorf("Expected no project, got %+v", project)
+	}
+
+	system, err := result.ExtractSystem()
+	testhelper.AssertNoErr(t, err)
+	if system != nil {
+		t.Errorf("Expected no system, got %+v", system)
+	}
+}
+
+func TestExtractSystemScope(t *testing.T) {
+	result := getGetResultFrom(t, SystemTokenOutput)
+
+	system, err := result.ExtractSystem()
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, &tokens.System{All: true}, system)
+
+	isSystem, err := result.ExtractIsSystemScoped()
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckEquals(t, true, isSystem)
+
+	roles, err := result.ExtractRoles()
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, []tokens.Role{
+		{ID: "434426788d5a451faf763b0e6db5aefb", Name: "admin"},
+		{ID: "9fe2ff9ee4384b1894a90878d3e92bab", Name: "reader"},
+	}, roles)
+
+	methods, err := result.ExtractMethods()
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, []string{"token", "password"}, methods)
+
+	auditIDs, err := result.ExtractAuditIDs()
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, []string{"3T2dc1CGQxyJsHdDu1xkcw", "ysSI0bEWR0Gmrp4LHL9LFw"}, auditIDs)
+}
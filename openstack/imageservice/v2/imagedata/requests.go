@@ -9,10 +9,11 @@ import (
 
 // Upload uploads an image file.
 func Upload(client *gophercloud.ServiceClient, id string, data io.Reader) (r UploadResult) {
-	_, r.Err = client.Put(uploadURL(client, id), data, nil, &gophercloud.RequestOpts{
+	resp, err := client.Put(uploadURL(client, id), data, nil, &gophercloud.RequestOpts{
 		MoreHeaders: map[string]string{"Content-Type": "application/octet-stream"},
 		OkCodes:     []int{204},
 	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
 
@@ -67,8 +67,9 @@ func Create(client *gophercloud.ServiceClient, queueName string, opts CreateOpts
 
 // Get queries the specified claim for the specified queue.
 func Get(client *gophercloud.ServiceClient, queueName string, claimID string) (r GetResult) {
-	_, r.Err = client.Get(getURL(client, queueName, claimID), &r.Body, &gophercloud.RequestOpts{
+	resp, err := client.Get(getURL(client, queueName, claimID), &r.Body, &gophercloud.RequestOpts{
 		OkCodes: []int{200},
 	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
@@ -92,7 +92,8 @@ func Create(c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResul
 		r.Err = err
 		return
 	}
-	_, r.Err = c.Post(createURL(c), b, &r.Body, nil)
+	resp, err := c.Post(createURL(c), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
 
@@ -181,7 +182,8 @@ func Adopt(c *gophercloud.ServiceClient, opts AdoptOptsBuilder) (r AdoptResult)
 		r.Err = err
 		return
 	}
-	_, r.Err = c.Post(adoptURL(c), b, &r.Body, nil)
+	resp, err := c.Post(adoptURL(c), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
 
@@ -255,7 +257,8 @@ func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
 
 // Get retreives a stack based on the stack name and stack ID.
 func Get(c *gophercloud.ServiceClient, stackName, stackID string) (r GetResult) {
-	_, r.Err = c.Get(getURL(c, stackName, stackID), &r.Body, nil)
+	resp, err := c.Get(getURL(c, stackName, stackID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
 
@@ -336,13 +339,15 @@ func Update(c *gophercloud.ServiceClient, stackName, stackID string, opts Update
 		r.Err = err
 		return
 	}
-	_, r.Err = c.Put(updateURL(c, stackName, stackID), b, nil, nil)
+	resp, err := c.Put(updateURL(c, stackName, stackID), b, nil, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
 
 // Delete deletes a stack based on the stack name and stack ID.
 func Delete(c *gophercloud.ServiceClient, stackName, stackID string) (r DeleteResult) {
-	_, r.Err = c.Delete(deleteURL(c, stackName, stackID), nil)
+	resp, err := c.Delete(deleteURL(c, stackName, stackID), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
 
@@ -423,18 +428,20 @@ func Preview(c *gophercloud.ServiceClient, opts PreviewOptsBuilder) (r PreviewRe
 		r.Err = err
 		return
 	}
-	_, r.Err = c.Post(previewURL(c), b, &r.Body, &gophercloud.RequestOpts{
+	resp, err := c.Post(previewURL(c), b, &r.Body, &gophercloud.RequestOpts{
 		OkCodes: []int{200},
 	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
 
 // Abandon deletes the stack with the provided stackName and stackID, but leaves its
 // resources intact, and returns data describing the stack and its resources.
 func Abandon(c *gophercloud.ServiceClient, stackName, stackID string) (r AbandonResult) {
-	_, r.Err = c.Delete(abandonURL(c, stackName, stackID), &gophercloud.RequestOpts{
+	resp, err := c.Delete(abandonURL(c, stackName, stackID), &gophercloud.RequestOpts{
 		JSONResponse: &r.Body,
 		OkCodes:      []int{200},
 	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
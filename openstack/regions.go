@@ -0,0 +1,188 @@
+package openstack
+
+import (
+	"sync"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// DefaultMaxParallelRegions is the number of regions handled concurrently by
+// ForEachRegion when RegionOpts.MaxParallel isn't set.
+const DefaultMaxParallelRegions = 4
+
+// Regions returns the sorted regions in which the service catalog of client
+// lists an endpoint matching eo. eo.Type is required, eo.Region is ignored,
+// and an endpoint matches if its availability is eo.Availability, which
+// defaults to public, or one of eo.AvailabilityFallbacks.
+func Regions(client *gophercloud.ProviderClient, eo gophercloud.EndpointOpts) ([]string, error) {
+	if eo.Type == "" {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "EndpointOpts.Type"
+		return nil, err
+	}
+
+	catalog := client.ServiceCatalog()
+	if catalog == nil {
+		return nil, ErrServiceCatalogUnavailable{}
+	}
+
+	eo.ApplyDefaults(eo.Type)
+	matching := &gophercloud.ServiceCatalog{}
+	for _, availability := range eo.Availabilities() {
+		matching.Endpoints = append(matching.Endpoints, catalog.Find(gophercloud.EndpointOpts{
+			Type:         eo.Type,
+			Name:         eo.Name,
+			Availability: availability,
+		})...)
+	}
+	return matching.Regions(), nil
+}
+
+// RegionOpts configures ForEachRegion.
+type RegionOpts struct {
+	// EndpointOpts selects the service endpoints. Region is set to each
+	// region in turn. Type is required unless Regions is set, in which case
+	// it defaults to the type of the clients returned by NewClient.
+	EndpointOpts gophercloud.EndpointOpts
+
+	// NewClient creates the ServiceClient of a region, e.g. NewComputeV2.
+	NewClient func(*gophercloud.ProviderClient, gophercloud.EndpointOpts) (*gophercloud.ServiceClient, error)
+
+	// Regions are the regions to handle. Defaults to the regions returned by
+	// Regions.
+	Regions []string
+
+	// MaxParallel is the maximum number of regions handled concurrently.
+	// Defaults to DefaultMaxParallelRegions.
+	MaxParallel int
+}
+
+// RegionFunc is called by ForEachRegion with the ServiceClient of a region.
+type RegionFunc func(client *gophercloud.ServiceClient) (interface{}, error)
+
+// RegionResult is the outcome of a RegionFunc for a region.
+type RegionResult struct {
+	// Region is the region the RegionFunc was called for.
+	Region string
+
+	// Value is the value returned by the RegionFunc.
+	Value interface{}
+
+	// Err is the error returned by the RegionFunc, or the error which
+	// prevented it from being called, e.g. if there is no endpoint in the
+	// region.
+	Err error
+}
+
+// RegionResults are the results of ForEachRegion.
+type RegionResults []RegionResult
+
+// Errors returns the errors of the regions which failed, by region.
+func (r RegionResults) Errors() map[string]error {
+	errs := make(map[string]error)
+	for _, result := range r {
+		if result.Err != nil {
+			errs[result.Region] = result.Err
+		}
+	}
+	return errs
+}
+
+/*
+ForEachRegion calls fn concurrently for each region of a service, with the
+ServiceClient of the region, and returns the results in the order of the
+regions. The regions are handled by at most opts.MaxParallel goroutines. A
+region failing doesn't prevent the others from being handled.
+
+The returned error is only set if the regions can't be determined, or if
+the endpoint of the service is overridden in client.EndpointOverrides, in
+which case it is an ErrEndpointOverridden since all the regions would use the
+same endpoint.
+
+	results, err := openstack.ForEachRegion(provider, openstack.RegionOpts{
+		EndpointOpts: gophercloud.EndpointOpts{Type: "compute"},
+		NewClient:    openstack.NewComputeV2,
+	}, func(client *gophercloud.ServiceClient) (interface{}, error) {
+		allPages, err := servers.List(client, nil).AllPages()
+		if err != nil {
+			return nil, err
+		}
+		return servers.ExtractServers(allPages)
+	})
+
+	for _, result := range results {
+		if result.Err != nil {
+			log.Printf("%s: %v", result.Region, result.Err)
+			continue
+		}
+		for _, server := range result.Value.([]servers.Server) {
+			fmt.Println(result.Region, server.Name)
+		}
+	}
+*/
+func ForEachRegion(client *gophercloud.ProviderClient, opts RegionOpts, fn RegionFunc) (RegionResults, error) {
+	if opts.NewClient == nil {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "RegionOpts.NewClient"
+		return nil, err
+	}
+
+	// The endpoint may be overridden under the default type of the service,
+	// which NewClient applies.
+	eo := opts.EndpointOpts
+	if eo.Type == "" && len(opts.Regions) > 0 {
+		probe := eo
+		probe.Region = opts.Regions[0]
+		if sc, err := opts.NewClient(client, probe); err == nil {
+			eo.Type = sc.Type
+		}
+	}
+
+	if _, ok := client.EndpointOverrides[eo.Type]; ok {
+		return nil, ErrEndpointOverridden{ServiceType: eo.Type}
+	}
+
+	regions := opts.Regions
+	if regions == nil {
+		var err error
+		regions, err = Regions(client, eo)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	maxParallel := opts.MaxParallel
+	if maxParallel <= 0 {
+		maxParallel = DefaultMaxParallelRegions
+	}
+
+	results := make(RegionResults, len(regions))
+	sem := make(chan struct{}, maxParallel)
+	var wg sync.WaitGroup
+	for i, region := range regions {
+		results[i].Region = region
+		wg.Add(1)
+		go func(result *RegionResult) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			if ctx := client.Context; ctx != nil && ctx.Err() != nil {
+				result.Err = ctx.Err()
+				return
+			}
+
+			eo := eo
+			eo.Region = result.Region
+			sc, err := opts.NewClient(client, eo)
+			if err != nil {
+				result.Err = err
+				return
+			}
+			result.Value, result.Err = fn(sc)
+		}(&results[i])
+	}
+	wg.Wait()
+
+	return results, nil
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/sharedfilesystems/v2/shares"
 	th "github.com/gophercloud/gophercloud/testhelper"
 	"github.com/gophercloud/gophercloud/testhelper/client"
@@ -107,6 +108,31 @@ func TestGetExportLocationsSuccess(t *testing.T) {
 	})
 }
 
+func TestGetExportLocationsUnsupportedMicroversion(t *testing.T) {
+	c := client.ServiceClient()
+	c.Type = "sharev2"
+	c.MinMicroversion = "2.0"
+	c.MaxMicroversion = "2.10"
+
+	_, err := shares.GetExportLocations(c, shareID).Extract()
+	if _, ok := err.(gophercloud.ErrMicroversionUnsupported); !ok {
+		t.Fatalf("Expected an ErrMicroversionUnsupported error, got %v", err)
+	}
+}
+
+func TestGetExportLocationsMicroversionNotRequested(t *testing.T) {
+	c := client.ServiceClient()
+	c.Type = "sharev2"
+	c.MinMicroversion = "2.0"
+	c.MaxMicroversion = "2.60"
+	c.Microversion = "2.7"
+
+	_, err := shares.GetExportLocations(c, shareID).Extract()
+	if _, ok := err.(gophercloud.ErrMicroversionUnsupported); !ok {
+		t.Fatalf("Expected an ErrMicroversionUnsupported error, got %v", err)
+	}
+}
+
 func TestGrantAcessSuccess(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
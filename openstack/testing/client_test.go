@@ -1,12 +1,17 @@
 package testing
 
 import (
+	"context"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/extensions/ec2tokens"
+	tokens3 "github.com/gophercloud/gophercloud/openstack/identity/v3/tokens"
 	th "github.com/gophercloud/gophercloud/testhelper"
 )
 
@@ -161,6 +166,21 @@ func TestAuthenticatedClientV2(t *testing.T) {
 	client, err := openstack.AuthenticatedClient(options)
 	th.AssertNoErr(t, err)
 	th.CheckEquals(t, "01234567890", client.TokenID)
+
+	catalog := client.ServiceCatalog()
+	if catalog == nil {
+		t.Fatal("expected the service catalog to be kept")
+	}
+	th.CheckDeepEquals(t, []string{"compute", "object-store"}, catalog.ServiceTypes())
+	th.CheckDeepEquals(t, []string{"North", "South"}, catalog.Regions())
+
+	url, err := catalog.EndpointURL(gophercloud.EndpointOpts{
+		Type:         "object-store",
+		Region:       "South",
+		Availability: gophercloud.AvailabilityInternal,
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "https://storage.south.internal/v1/t1000/", url)
 }
 
 func TestIdentityAdminV3Client(t *testing.T) {
@@ -313,3 +333,273 @@ func TestAuthenticatedClientV3Fails(t *testing.T) {
 func TestAuthenticatedClientV2Fails(t *testing.T) {
 	testAuthenticatedClientFails(t, "http://bad-address.example.com/v2.0")
 }
+
+type memoryTokenCache map[string]*gophercloud.CachedToken
+
+func (c memoryTokenCache) Load(key string) (*gophercloud.CachedToken, error) {
+	return c[key], nil
+}
+
+func (c memoryTokenCache) Store(key string, token *gophercloud.CachedToken) error {
+	c[key] = token
+	return nil
+}
+
+func TestAuthenticateV3TokenCache(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	requests := 0
+	th.Mux.HandleFunc("/v3/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Add("X-Subject-Token", ID)
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `
+			{
+				"token": {
+					"expires_at": "2099-02-02T18:30:59.000000Z",
+					"catalog": [
+						{
+							"type": "compute",
+							"endpoints": [
+								{ "interface": "public", "region": "RegionOne", "url": "https://compute.example.com/v2.1" }
+							]
+						}
+					]
+				}
+			}
+		`)
+	})
+
+	cache := memoryTokenCache{}
+	authenticate := func(password string) *gophercloud.ProviderClient {
+		client, err := openstack.NewClient(th.Endpoint() + "v3/")
+		th.AssertNoErr(t, err)
+		client.TokenCache = cache
+
+		err = openstack.Authenticate(client, gophercloud.AuthOptions{
+			Username:   "me",
+			Password:   password,
+			DomainName: "default",
+			TenantName: "project",
+		})
+		th.AssertNoErr(t, err)
+		return client
+	}
+
+	first := authenticate("secret")
+	th.AssertEquals(t, 1, requests)
+	th.AssertEquals(t, 1, len(cache))
+
+	second := authenticate("secret")
+	th.AssertEquals(t, 1, requests)
+	th.AssertEquals(t, ID, second.Token())
+	th.AssertEquals(t, first.TokenExpiresAt(), second.TokenExpiresAt())
+	th.AssertEquals(t, time.Date(2099, 2, 2, 18, 30, 59, 0, time.UTC), second.TokenExpiresAt())
+
+	url, err := second.EndpointLocator(gophercloud.EndpointOpts{Type: "compute", Region: "RegionOne", Availability: gophercloud.AvailabilityPublic})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "https://compute.example.com/v2.1/", url)
+
+	_, ok := second.GetAuthResult().(tokens3.CreateResult)
+	th.AssertEquals(t, true, ok)
+
+	// A token isn't reused with another password.
+	authenticate("another secret")
+	th.AssertEquals(t, 2, requests)
+	th.AssertEquals(t, 2, len(cache))
+}
+
+func TestAuthenticateV3ReauthContext(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	requests := 0
+	aborted := make(chan bool, 1)
+	th.Mux.HandleFunc("/v3/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests > 1 {
+			// The caller gives up while the client is reauthenticating.
+			// The server only notices once the body has been read.
+			ioutil.ReadAll(r.Body)
+			cancel()
+			select {
+			case <-r.Context().Done():
+				aborted <- true
+				return
+			case <-time.After(5 * time.Second):
+				aborted <- false
+			}
+		}
+		w.Header().Add("X-Subject-Token", ID)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{ "token": { "expires_at": "2099-02-02T18:30:59.000000Z" } }`)
+	})
+	th.Mux.HandleFunc("/resource", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	client, err := openstack.NewClient(th.Endpoint() + "v3/")
+	th.AssertNoErr(t, err)
+	err = openstack.Authenticate(client, gophercloud.AuthOptions{
+		UserID:      "me",
+		Password:    "secret",
+		AllowReauth: true,
+	})
+	th.AssertNoErr(t, err)
+
+	_, err = client.Request("GET", th.Endpoint()+"resource", &gophercloud.RequestOpts{Context: ctx})
+	if err == nil {
+		t.Fatal("expecting error, got nil")
+	}
+	th.AssertEquals(t, 2, requests)
+	th.AssertEquals(t, true, <-aborted)
+}
+
+func TestAuthenticateV3TokenCachePasscode(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	requests := 0
+	th.Mux.HandleFunc("/v3/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Add("X-Subject-Token", ID)
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{ "token": { "expires_at": "2099-02-02T18:30:59.000000Z" } }`)
+	})
+
+	// The one-time passcode isn't part of the cache key.
+	cache := memoryTokenCache{}
+	for _, passcode := range []string{"123456", "654321"} {
+		client, err := openstack.NewClient(th.Endpoint() + "v3/")
+		th.AssertNoErr(t, err)
+		client.TokenCache = cache
+
+		err = openstack.AuthenticateV3(client, &tokens3.AuthOptions{
+			UserID:   "me",
+			Password: "secret",
+			Passcode: passcode,
+		}, gophercloud.EndpointOpts{})
+		th.AssertNoErr(t, err)
+		th.AssertEquals(t, ID, client.Token())
+	}
+	th.AssertEquals(t, 1, requests)
+	th.AssertEquals(t, 1, len(cache))
+}
+
+func TestAuthenticateV3EC2Tokens(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	requests := 0
+	th.Mux.HandleFunc("/v3/ec2tokens", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		th.TestMethod(t, r, "POST")
+		w.Header().Add("X-Subject-Token", ID)
+
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{ "token": { "expires_at": "2099-02-02T18:30:59.000000Z" } }`)
+	})
+
+	cache := memoryTokenCache{}
+	for i := 0; i < 2; i++ {
+		client, err := openstack.NewClient(th.Endpoint() + "v3/")
+		th.AssertNoErr(t, err)
+		client.TokenCache = cache
+
+		// The signed request changes with time, but the cache key doesn't.
+		timestamp := time.Now().Add(time.Duration(i) * time.Hour)
+		err = openstack.AuthenticateV3(client, &ec2tokens.AuthOptions{
+			Access:      "a7f1e798b7c2417cba4a02de97dc3cdc",
+			Secret:      "18f4f6761ada4e3795fa5273c30349b9",
+			Timestamp:   &timestamp,
+			AllowReauth: true,
+		}, gophercloud.EndpointOpts{})
+		th.AssertNoErr(t, err)
+		th.AssertEquals(t, ID, client.Token())
+		th.AssertEquals(t, true, client.ReauthFunc != nil)
+	}
+	th.AssertEquals(t, 1, requests)
+	th.AssertEquals(t, 1, len(cache))
+}
+
+// customAuthMethod obtains tokens from a custom endpoint of the identity
+// service.
+type customAuthMethod struct {
+	tokens3.AuthOptions
+	creations *int
+}
+
+func (opts *customAuthMethod) CreateToken(client *gophercloud.ServiceClient) (r tokens3.CreateResult) {
+	*opts.creations++
+	resp, err := client.Post(client.ServiceURL("custom"), nil, &r.Body, &gophercloud.RequestOpts{
+		MoreHeaders: map[string]string{"X-Auth-Token": ""},
+		OkCodes:     []int{201},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+func (opts *customAuthMethod) TokenCacheRequest(map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{"custom": opts.UserID}
+}
+
+func (opts *customAuthMethod) WithoutReauth() tokens3.AuthOptionsBuilder {
+	o := *opts
+	o.AllowReauth = false
+	return &o
+}
+
+func TestAuthenticateV3AuthMethod(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v3/custom", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		w.Header().Add("X-Subject-Token", ID)
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{ "token": { "expires_at": "2099-02-02T18:30:59.000000Z" } }`)
+	})
+
+	client, err := openstack.NewClient(th.Endpoint() + "v3/")
+	th.AssertNoErr(t, err)
+	client.TokenCache = memoryTokenCache{}
+
+	creations := 0
+	err = openstack.AuthenticateV3(client, &customAuthMethod{
+		AuthOptions: tokens3.AuthOptions{UserID: "me", AllowReauth: true},
+		creations:   &creations,
+	}, gophercloud.EndpointOpts{})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, ID, client.Token())
+	th.AssertEquals(t, 1, creations)
+
+	// Reauthentication bypasses the cache.
+	th.AssertNoErr(t, client.Reauthenticate(""))
+	th.AssertEquals(t, 2, creations)
+}
+
+func TestEndpointOverrides(t *testing.T) {
+	client := &gophercloud.ProviderClient{
+		EndpointLocator: func(eo gophercloud.EndpointOpts) (string, error) {
+			return "https://catalog.example.com/" + eo.Type + "/", nil
+		},
+		EndpointOverrides: map[string]string{
+			"compute": "https://nova.internal.example.com/v2.1",
+		},
+	}
+
+	compute, err := openstack.NewComputeV2(client, gophercloud.EndpointOpts{Region: "RegionOne"})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "https://nova.internal.example.com/v2.1/", compute.Endpoint)
+
+	network, err := openstack.NewNetworkV2(client, gophercloud.EndpointOpts{Region: "RegionOne"})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "https://catalog.example.com/network/", network.Endpoint)
+}
@@ -272,3 +272,118 @@ func TestV3EndpointWithRegionID(t *testing.T) {
 		th.CheckEquals(t, expected, actual)
 	}
 }
+
+func TestV2EndpointFallback(t *testing.T) {
+	actual, err := openstack.V2EndpointURL(&catalog2, gophercloud.EndpointOpts{
+		Type:                  "same",
+		Name:                  "same",
+		Region:                "different",
+		Availability:          gophercloud.AvailabilityInternal,
+		AvailabilityFallbacks: []gophercloud.Availability{gophercloud.AvailabilityAdmin, gophercloud.AvailabilityPublic},
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "https://badregion.com/", actual)
+}
+
+func TestV3EndpointFallback(t *testing.T) {
+	catalog := tokens3.ServiceCatalog{
+		Entries: []tokens3.CatalogEntry{
+			{
+				Type: "compute",
+				Endpoints: []tokens3.Endpoint{
+					{ID: "1", RegionID: "RegionOne", Interface: "public", URL: "https://public.example.com"},
+					{ID: "2", RegionID: "RegionOne", Interface: "admin", URL: "https://admin.example.com"},
+				},
+			},
+		},
+	}
+
+	actual, err := openstack.V3EndpointURL(&catalog, gophercloud.EndpointOpts{
+		Type:                  "compute",
+		Availability:          gophercloud.AvailabilityInternal,
+		AvailabilityFallbacks: []gophercloud.Availability{gophercloud.AvailabilityPublic},
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "https://public.example.com/", actual)
+
+	_, err = openstack.V3EndpointURL(&catalog, gophercloud.EndpointOpts{
+		Type:         "compute",
+		Availability: gophercloud.AvailabilityInternal,
+	})
+	if _, ok := err.(*gophercloud.ErrEndpointNotFound); !ok {
+		t.Fatalf("expected ErrEndpointNotFound, got %T: %v", err, err)
+	}
+}
+
+func TestV3ServiceCatalog(t *testing.T) {
+	catalog := openstack.V3ServiceCatalog(&catalog3)
+
+	th.CheckDeepEquals(t, []string{"different", "same", "someother"}, catalog.ServiceTypes())
+	th.CheckDeepEquals(t, []string{"different", "same", "someother"}, catalog.Regions())
+	th.CheckDeepEquals(t, []gophercloud.CatalogEndpoint{
+		{
+			ServiceName:  "someother",
+			ServiceType:  "someother",
+			ID:           "2",
+			Region:       "someother",
+			Availability: gophercloud.AvailabilityAdmin,
+			URL:          "https://admin.correct.com/",
+		},
+	}, catalog.Find(gophercloud.EndpointOpts{
+		Region:       "someother",
+		Availability: gophercloud.AvailabilityAdmin,
+	}))
+
+	actual, err := catalog.EndpointURL(gophercloud.EndpointOpts{
+		Type:   "same",
+		Name:   "same",
+		Region: "same",
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "https://public.correct.com/", actual)
+
+	_, err = catalog.EndpointURL(gophercloud.EndpointOpts{
+		Type:   "same",
+		Region: "same",
+	})
+	if e, ok := err.(gophercloud.ErrMultipleMatchingEndpoints); !ok || len(e.Endpoints) != 2 {
+		t.Fatalf("expected ErrMultipleMatchingEndpoints with 2 endpoints, got %T: %v", err, err)
+	}
+}
+
+func TestV2ServiceCatalog(t *testing.T) {
+	catalog := openstack.V2ServiceCatalog(&catalog2)
+
+	th.CheckDeepEquals(t, []gophercloud.CatalogEndpoint{
+		{
+			ServiceName:  "same",
+			ServiceType:  "same",
+			Region:       "same",
+			Availability: gophercloud.AvailabilityPublic,
+			URL:          "https://public.correct.com/",
+		},
+		{
+			ServiceName:  "same",
+			ServiceType:  "same",
+			Region:       "same",
+			Availability: gophercloud.AvailabilityInternal,
+			URL:          "https://internal.correct.com/",
+		},
+		{
+			ServiceName:  "same",
+			ServiceType:  "same",
+			Region:       "same",
+			Availability: gophercloud.AvailabilityAdmin,
+			URL:          "https://admin.correct.com/",
+		},
+	}, catalog.Find(gophercloud.EndpointOpts{Type: "same", Name: "same", Region: "same"}))
+
+	actual, err := catalog.EndpointURL(gophercloud.EndpointOpts{
+		Type:                  "different",
+		Region:                "different",
+		Availability:          gophercloud.AvailabilityInternal,
+		AvailabilityFallbacks: []gophercloud.Availability{gophercloud.AvailabilityPublic},
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "https://badtype.com/+badregion+badname/", actual)
+}
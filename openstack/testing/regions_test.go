@@ -0,0 +1,193 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack"
+	tokens3 "github.com/gophercloud/gophercloud/openstack/identity/v3/tokens"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func regionsProviderClient() *gophercloud.ProviderClient {
+	catalog := &tokens3.ServiceCatalog{
+		Entries: []tokens3.CatalogEntry{
+			{
+				Type: "compute",
+				Endpoints: []tokens3.Endpoint{
+					{ID: "1", RegionID: "RegionOne", Interface: "public", URL: th.Endpoint() + "one"},
+					{ID: "2", RegionID: "RegionTwo", Interface: "public", URL: th.Endpoint() + "two"},
+					{ID: "3", RegionID: "RegionThree", Interface: "public", URL: th.Endpoint() + "three"},
+					{ID: "4", RegionID: "RegionFour", Interface: "internal", URL: th.Endpoint() + "four"},
+				},
+			},
+			{
+				Type: "network",
+				Endpoints: []tokens3.Endpoint{
+					{ID: "5", RegionID: "RegionFive", Interface: "public", URL: th.Endpoint() + "five"},
+				},
+			},
+		},
+	}
+
+	client := &gophercloud.ProviderClient{TokenID: "token"}
+	client.SetServiceCatalog(openstack.V3ServiceCatalog(catalog))
+	client.EndpointLocator = func(eo gophercloud.EndpointOpts) (string, error) {
+		return openstack.V3EndpointURL(catalog, eo)
+	}
+	return client
+}
+
+func TestRegions(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	client := regionsProviderClient()
+
+	regions, err := openstack.Regions(client, gophercloud.EndpointOpts{Type: "compute"})
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []string{"RegionOne", "RegionThree", "RegionTwo"}, regions)
+
+	regions, err = openstack.Regions(client, gophercloud.EndpointOpts{
+		Type:                  "compute",
+		Availability:          gophercloud.AvailabilityInternal,
+		AvailabilityFallbacks: []gophercloud.Availability{gophercloud.AvailabilityPublic},
+	})
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []string{"RegionFour", "RegionOne", "RegionThree", "RegionTwo"}, regions)
+
+	_, err = openstack.Regions(new(gophercloud.ProviderClient), gophercloud.EndpointOpts{Type: "compute"})
+	if _, ok := err.(openstack.ErrServiceCatalogUnavailable); !ok {
+		t.Fatalf("expected ErrServiceCatalogUnavailable, got %T: %v", err, err)
+	}
+}
+
+func TestForEachRegion(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	var mu sync.Mutex
+	var inFlight, maxInFlight int
+	for _, name := range []string{"one", "two", "three"} {
+		name := name
+		th.Mux.HandleFunc("/"+name+"/servers", func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			inFlight++
+			if inFlight > maxInFlight {
+				maxInFlight = inFlight
+			}
+			mu.Unlock()
+			defer func() {
+				mu.Lock()
+				inFlight--
+				mu.Unlock()
+			}()
+
+			if name == "three" {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"servers": [{"name": "%s"}]}`, name)
+		})
+	}
+
+	results, err := openstack.ForEachRegion(regionsProviderClient(), openstack.RegionOpts{
+		EndpointOpts: gophercloud.EndpointOpts{Type: "compute"},
+		NewClient:    openstack.NewComputeV2,
+		MaxParallel:  1,
+	}, func(client *gophercloud.ServiceClient) (interface{}, error) {
+		var body struct {
+			Servers []struct {
+				Name string `json:"name"`
+			} `json:"servers"`
+		}
+		_, err := client.Get(client.ServiceURL("servers"), &body, nil)
+		if err != nil {
+			return nil, err
+		}
+		return body.Servers[0].Name, nil
+	})
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 1, maxInFlight)
+	th.AssertEquals(t, 3, len(results))
+
+	th.AssertEquals(t, "RegionOne", results[0].Region)
+	th.AssertNoErr(t, results[0].Err)
+	th.AssertEquals(t, "one", results[0].Value)
+
+	th.AssertEquals(t, "RegionThree", results[1].Region)
+	if _, ok := results[1].Err.(gophercloud.ErrDefault500); !ok {
+		t.Errorf("expected ErrDefault500, got %T: %v", results[1].Err, results[1].Err)
+	}
+
+	th.AssertEquals(t, "RegionTwo", results[2].Region)
+	th.AssertNoErr(t, results[2].Err)
+	th.AssertEquals(t, "two", results[2].Value)
+
+	errs := results.Errors()
+	th.AssertEquals(t, 1, len(errs))
+	if _, ok := errs["RegionThree"].(gophercloud.ErrDefault500); !ok {
+		t.Errorf("expected ErrDefault500 for RegionThree, got %v", errs)
+	}
+}
+
+func TestForEachRegionNoEndpoint(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	results, err := openstack.ForEachRegion(regionsProviderClient(), openstack.RegionOpts{
+		EndpointOpts: gophercloud.EndpointOpts{Type: "compute"},
+		NewClient:    openstack.NewComputeV2,
+		Regions:      []string{"RegionFive"},
+	}, func(client *gophercloud.ServiceClient) (interface{}, error) {
+		t.Fatal("unexpected call for a region without endpoint")
+		return nil, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(results))
+	if _, ok := results[0].Err.(*gophercloud.ErrEndpointNotFound); !ok {
+		t.Errorf("expected ErrEndpointNotFound, got %T: %v", results[0].Err, results[0].Err)
+	}
+}
+
+func TestForEachRegionEndpointOverridden(t *testing.T) {
+	client := regionsProviderClient()
+	client.EndpointOverrides = map[string]string{
+		"compute": "https://nova.internal.example.com/v2.1",
+	}
+
+	_, err := openstack.ForEachRegion(client, openstack.RegionOpts{
+		EndpointOpts: gophercloud.EndpointOpts{Type: "compute"},
+		NewClient:    openstack.NewComputeV2,
+	}, func(client *gophercloud.ServiceClient) (interface{}, error) {
+		t.Fatal("unexpected call for an overridden endpoint")
+		return nil, nil
+	})
+	if _, ok := err.(openstack.ErrEndpointOverridden); !ok {
+		t.Fatalf("expected ErrEndpointOverridden, got %T: %v", err, err)
+	}
+}
+
+func TestForEachRegionEndpointOverriddenDefaultType(t *testing.T) {
+	client := regionsProviderClient()
+	client.EndpointOverrides = map[string]string{
+		"compute": "https://nova.internal.example.com/v2.1",
+	}
+
+	// NewComputeV2 defaults the type to compute.
+	_, err := openstack.ForEachRegion(client, openstack.RegionOpts{
+		NewClient: openstack.NewComputeV2,
+		Regions:   []string{"RegionOne", "RegionTwo"},
+	}, func(client *gophercloud.ServiceClient) (interface{}, error) {
+		t.Fatal("unexpected call for an overridden endpoint")
+		return nil, nil
+	})
+	if _, ok := err.(openstack.ErrEndpointOverridden); !ok {
+		t.Fatalf("expected ErrEndpointOverridden, got %T: %v", err, err)
+	}
+}
@@ -0,0 +1,143 @@
+package openstack
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	tokens3 "github.com/gophercloud/gophercloud/openstack/identity/v3/tokens"
+)
+
+// tokenCacheOneTimeCredentials lists the fields of an authentication request
+// which are left out of its TokenCache key, so that a one-time passcode
+// doesn't prevent reusing a token.
+var tokenCacheOneTimeCredentials = map[string]bool{
+	"passcode": true,
+}
+
+// tokenCacheSecrets lists the fields of an authentication request which are
+// hashed in its TokenCache key, so that a token is only reused with the
+// secret it was obtained with.
+var tokenCacheSecrets = map[string]bool{
+	"password": true,
+	"secret":   true,
+}
+
+// tokenCacheKey derives the TokenCache key of an authentication request
+// against the given identity endpoint. The key identifies the user, secrets
+// and scope of the request, but not its one-time passcode.
+func tokenCacheKey(endpoint string, opts tokens3.AuthOptionsBuilder) (string, error) {
+	scope, err := opts.ToTokenV3ScopeMap()
+	if err != nil {
+		return "", err
+	}
+
+	var b map[string]interface{}
+	if m, ok := opts.(tokens3.AuthMethod); ok {
+		b = m.TokenCacheRequest(scope)
+	} else {
+		b, err = opts.ToTokenV3CreateMap(scope)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	// Round-trip the request body so that it only holds generic maps, which
+	// json.Marshal encodes with sorted keys.
+	j, err := json.Marshal(b)
+	if err != nil {
+		return "", err
+	}
+	var body interface{}
+	if err := json.Unmarshal(j, &body); err != nil {
+		return "", err
+	}
+
+	j, err = json.Marshal(map[string]interface{}{
+		"endpoint": endpoint,
+		"auth":     hideCredentials(body),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(j)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// hideCredentials removes the tokenCacheOneTimeCredentials fields from a
+// decoded JSON document, and replaces the tokenCacheSecrets fields by their
+// SHA-256 hash.
+func hideCredentials(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			switch e := e.(type) {
+			case map[string]interface{}, []interface{}:
+				v[k] = hideCredentials(e)
+			case string:
+				if tokenCacheOneTimeCredentials[k] {
+					delete(v, k)
+				} else if tokenCacheSecrets[k] {
+					sum := sha256.Sum256([]byte(e))
+					v[k] = hex.EncodeToString(sum[:])
+				}
+			default:
+				if tokenCacheOneTimeCredentials[k] {
+					delete(v, k)
+				}
+			}
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = hideCredentials(e)
+		}
+	}
+	return v
+}
+
+// loadCachedToken looks up a token in the TokenCache of client. It is only
+// returned if it remains valid for longer than the TokenRefreshMargin of the
+// client. Cache errors are ignored, the token is then obtained from Keystone.
+func loadCachedToken(client *gophercloud.ProviderClient, key string) (tokens3.CreateResult, bool) {
+	var result tokens3.CreateResult
+
+	token, err := client.TokenCache.Load(key)
+	if err != nil || token == nil || token.ID == "" {
+		return result, false
+	}
+	if time.Until(token.ExpiresAt) <= client.TokenRefreshMargin {
+		return result, false
+	}
+
+	if err := json.Unmarshal(token.Body, &result.Body); err != nil {
+		return result, false
+	}
+	result.Header = http.Header{}
+	result.Header.Set("X-Subject-Token", token.ID)
+
+	return result, true
+}
+
+// storeCachedToken saves the token issued by result in cache. Cache errors
+// are ignored, since the token is valid regardless.
+func storeCachedToken(cache gophercloud.TokenCache, key string, result tokens3.CreateResult) {
+	token, err := result.ExtractToken()
+	if err != nil {
+		return
+	}
+
+	body, err := json.Marshal(result.Body)
+	if err != nil {
+		return
+	}
+
+	cache.Store(key, &gophercloud.CachedToken{
+		ID:        token.ID,
+		ExpiresAt: token.ExpiresAt,
+		Body:      body,
+	})
+}
@@ -0,0 +1,145 @@
+package utils
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// SupportedMicroversions is the range of microversions supported by a
+// service. Both are empty if the service doesn't support microversions.
+type SupportedMicroversions struct {
+	Min string
+	Max string
+}
+
+// versionedEndpointRe matches the part of an endpoint up to its API version,
+// such as "https://cinder.example.com/v3/" in
+// "https://cinder.example.com/v3/<project>/".
+var versionedEndpointRe = regexp.MustCompile(`^.*?/(v[0-9.]+)/`)
+
+// GetSupportedMicroversions reads the version document of the service of
+// client, such as compute, volume or sharev2, and returns the range of
+// microversions it supports.
+func GetSupportedMicroversions(client *gophercloud.ServiceClient) (SupportedMicroversions, error) {
+	type valueResp struct {
+		ID         string `json:"id"`
+		Status     string `json:"status"`
+		Version    string `json:"version"`
+		MinVersion string `json:"min_version"`
+	}
+
+	type response struct {
+		Version  *valueResp  `json:"version"`
+		Versions []valueResp `json:"versions"`
+	}
+
+	// The version document is served at the versioned root of the API, which
+	// precedes the project ID in some catalogs.
+	endpoint := gophercloud.NormalizeURL(client.Endpoint)
+	var versionID string
+	if m := versionedEndpointRe.FindStringSubmatch(endpoint); m != nil {
+		endpoint, versionID = m[0], m[1]
+	}
+
+	var resp response
+	_, err := client.Get(endpoint, &resp, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 300},
+	})
+	if err != nil {
+		return SupportedMicroversions{}, err
+	}
+
+	var value *valueResp
+	switch {
+	case resp.Version != nil:
+		value = resp.Version
+	default:
+		// Prefer the version of the endpoint, then the current version.
+		for i, v := range resp.Versions {
+			if versionID != "" && sameVersionID(v.ID, versionID) {
+				value = &resp.Versions[i]
+				break
+			}
+			if strings.EqualFold(v.Status, "current") && value == nil {
+				value = &resp.Versions[i]
+			}
+		}
+	}
+
+	if value == nil || value.Version == "" {
+		return SupportedMicroversions{}, nil
+	}
+	return SupportedMicroversions{Min: value.MinVersion, Max: value.Version}, nil
+}
+
+// sameVersionID reports whether two API version IDs are the same, the minor
+// version defaulting to 0: the "v3" of a Block Storage endpoint is listed as
+// "v3.0" in its version document.
+func sameVersionID(a, b string) bool {
+	normalize := func(id string) string {
+		id = strings.ToLower(id)
+		if !strings.Contains(id, ".") {
+			id += ".0"
+		}
+		return id
+	}
+	return normalize(a) == normalize(b)
+}
+
+// NegotiateMicroversion reads the microversions supported by the service of
+// client, records them in its MinMicroversion and MaxMicroversion fields and
+// sets its Microversion to the highest one which isn't above max. The
+// highest supported microversion is used if max is empty.
+//
+// An ErrMicroversionUnsupported error is returned if the service doesn't
+// support microversions, or only supports microversions above max.
+func NegotiateMicroversion(client *gophercloud.ServiceClient, max string) (string, error) {
+	supported, err := GetSupportedMicroversions(client)
+	if err != nil {
+		return "", err
+	}
+
+	client.MinMicroversion = supported.Min
+	client.MaxMicroversion = supported.Max
+
+	required := max
+	if required == "" {
+		required = supported.Max
+	}
+
+	unsupported := gophercloud.ErrMicroversionUnsupported{
+		ServiceType: client.Type,
+		Required:    required,
+		Min:         supported.Min,
+		Max:         supported.Max,
+	}
+	if supported.Max == "" {
+		return "", unsupported
+	}
+
+	version := supported.Max
+	if max != "" {
+		c, err := gophercloud.CompareMicroversions(max, supported.Max)
+		if err != nil {
+			return "", err
+		}
+		if c < 0 {
+			version = max
+		}
+	}
+
+	if supported.Min != "" {
+		c, err := gophercloud.CompareMicroversions(version, supported.Min)
+		if err != nil {
+			return "", err
+		}
+		if c < 0 {
+			return "", unsupported
+		}
+	}
+
+	client.Microversion = version
+	return version, nil
+}
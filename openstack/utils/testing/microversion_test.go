@@ -0,0 +1,171 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/utils"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+const computeVersionResponse = `
+{
+	"version": {
+		"id": "v2.1",
+		"status": "CURRENT",
+		"version": "2.79",
+		"min_version": "2.1"
+	}
+}
+`
+
+const volumeVersionsResponse = `
+{
+	"versions": [
+		{
+			"id": "v3.0",
+			"status": "CURRENT",
+			"version": "3.59",
+			"min_version": "3.0"
+		}
+	]
+}
+`
+
+const cinderVersionsResponse = `
+{
+	"versions": [
+		{
+			"id": "v2.0",
+			"status": "DEPRECATED",
+			"version": "",
+			"min_version": "",
+			"links": [
+				{ "href": "%[1]sv2/", "rel": "self" }
+			]
+		},
+		{
+			"id": "v3.0",
+			"status": "CURRENT",
+			"version": "3.59",
+			"min_version": "3.0",
+			"links": [
+				{ "href": "%[1]sv3/", "rel": "self" }
+			]
+		}
+	]
+}
+`
+
+func serviceClient(serviceType, endpoint string) *gophercloud.ServiceClient {
+	sc := client.ServiceClient()
+	sc.Type = serviceType
+	sc.Endpoint = endpoint
+	return sc
+}
+
+func TestGetSupportedMicroversions(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.1/", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		fmt.Fprintf(w, computeVersionResponse)
+	})
+
+	supported, err := utils.GetSupportedMicroversions(serviceClient("compute", th.Endpoint()+"v2.1/"))
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, utils.SupportedMicroversions{Min: "2.1", Max: "2.79"}, supported)
+}
+
+func TestGetSupportedMicroversionsProjectEndpoint(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v3/", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.AssertEquals(t, "/v3/", r.URL.Path)
+		fmt.Fprintf(w, volumeVersionsResponse)
+	})
+
+	supported, err := utils.GetSupportedMicroversions(serviceClient("volumev3", th.Endpoint()+"v3/project"))
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, utils.SupportedMicroversions{Min: "3.0", Max: "3.59"}, supported)
+}
+
+func TestGetSupportedMicroversionsMultipleVersions(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	for _, path := range []string{"/v2/", "/v3/"} {
+		th.Mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "GET")
+			fmt.Fprintf(w, cinderVersionsResponse, th.Endpoint())
+		})
+	}
+
+	supported, err := utils.GetSupportedMicroversions(serviceClient("volumev3", th.Endpoint()+"v3/project"))
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, utils.SupportedMicroversions{Min: "3.0", Max: "3.59"}, supported)
+
+	// The v2 API doesn't support microversions, unlike the current version.
+	supported, err = utils.GetSupportedMicroversions(serviceClient("volumev2", th.Endpoint()+"v2/project"))
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, utils.SupportedMicroversions{}, supported)
+}
+
+func TestNegotiateMicroversion(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.1/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, computeVersionResponse)
+	})
+
+	sc := serviceClient("compute", th.Endpoint()+"v2.1/")
+	version, err := utils.NegotiateMicroversion(sc, "")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "2.79", version)
+	th.AssertEquals(t, "2.79", sc.Microversion)
+	th.AssertEquals(t, "2.1", sc.MinMicroversion)
+	th.AssertEquals(t, "2.79", sc.MaxMicroversion)
+
+	version, err = utils.NegotiateMicroversion(sc, "2.60")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "2.60", version)
+	th.AssertEquals(t, "2.60", sc.Microversion)
+
+	version, err = utils.NegotiateMicroversion(sc, "2.100")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "2.79", version)
+
+	err = sc.RequireMicroversion("2.80")
+	if _, ok := err.(gophercloud.ErrMicroversionUnsupported); !ok {
+		t.Fatalf("Expected an ErrMicroversionUnsupported error, got %v", err)
+	}
+	th.AssertNoErr(t, sc.RequireMicroversion("2.53"))
+}
+
+func TestNegotiateMicroversionUnsupported(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.1/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, computeVersionResponse)
+	})
+
+	sc := serviceClient("compute", th.Endpoint()+"v2.1/")
+	_, err := utils.NegotiateMicroversion(sc, "1.5")
+	e, ok := err.(gophercloud.ErrMicroversionUnsupported)
+	if !ok {
+		t.Fatalf("Expected an ErrMicroversionUnsupported error, got %v", err)
+	}
+	th.AssertEquals(t, "compute", e.ServiceType)
+	th.AssertEquals(t, "1.5", e.Required)
+	th.AssertEquals(t, "2.79", e.Max)
+	th.AssertEquals(t, "", sc.Microversion)
+}
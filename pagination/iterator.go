@@ -0,0 +1,307 @@
+package pagination
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// PageIterator fetches the pages of a Pager lazily, one at a time. Unlike
+// EachPage, it lets the caller drive the iteration. Close must be called once
+// the caller is done with the iterator, to stop any prefetching:
+//
+//	pages := servers.List(client, nil).Pages()
+//	defer pages.Close()
+//	for pages.Next() {
+//		s, err := servers.ExtractServers(pages.Page())
+//		...
+//	}
+//	if err := pages.Err(); err != nil {
+//		...
+//	}
+type PageIterator struct {
+	pager Pager
+	url   string
+	page  Page
+	err   error
+	done  bool
+
+	// results receives the pages fetched ahead when the Pager has a
+	// Prefetch, and stop ends the fetching.
+	results chan pageOrError
+	stop    func()
+}
+
+type pageOrError struct {
+	page Page
+	err  error
+}
+
+// Pages returns a PageIterator over the pages of the Pager. The caller must
+// Close it.
+func (p Pager) Pages() *PageIterator {
+	return &PageIterator{
+		pager: p,
+		url:   p.initialURL,
+		err:   p.Err,
+		done:  p.Err != nil,
+	}
+}
+
+// Next fetches the next page. It returns false once there are no more pages,
+// or if an error occurred, which is then returned by Err.
+func (it *PageIterator) Next() bool {
+	if it.done {
+		return false
+	}
+
+	if it.pager.Prefetch > 0 {
+		return it.nextPrefetched()
+	}
+
+	if it.page != nil {
+		url, err := it.page.NextPageURL()
+		if err != nil {
+			return it.fail(err)
+		}
+		if url == "" {
+			return it.fail(nil)
+		}
+		it.url = url
+	}
+
+	if it.pager.Context != nil {
+		if err := it.pager.Context.Err(); err != nil {
+			return it.fail(err)
+		}
+	}
+
+	page, err := it.pager.fetchNextPage(it.url)
+	if err != nil {
+		return it.fail(err)
+	}
+
+	empty, err := page.IsEmpty()
+	if err != nil {
+		return it.fail(err)
+	}
+	if empty {
+		return it.fail(nil)
+	}
+
+	it.page = page
+	return true
+}
+
+// nextPrefetched is Next for a Pager with a Prefetch. The pages are fetched
+// by a goroutine started on the first call.
+func (it *PageIterator) nextPrefetched() bool {
+	if it.results == nil {
+		it.startPrefetch()
+	}
+
+	r, ok := <-it.results
+	if !ok {
+		return it.fail(nil)
+	}
+	if r.err != nil {
+		return it.fail(r.err)
+	}
+
+	it.page = r.page
+	return true
+}
+
+// startPrefetch starts fetching the pages in a goroutine. The pages are sent
+// in order to it.results, which is closed after the last page or an error.
+// A buffered page and the one blocked sending make up the Prefetch.
+func (it *PageIterator) startPrefetch() {
+	ctx := it.pager.Context
+	if ctx == nil && it.pager.client.ProviderClient != nil {
+		ctx = it.pager.client.ProviderClient.Context
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithCancel(ctx)
+
+	pager := it.pager
+	pager.Context = ctx
+	results := make(chan pageOrError, it.pager.Prefetch-1)
+	stop := make(chan struct{})
+	it.results = results
+	it.stop = func() {
+		close(stop)
+		cancel()
+	}
+
+	go func(url string) {
+		defer close(results)
+
+		send := func(r pageOrError) bool {
+			select {
+			case results <- r:
+				return true
+			case <-stop:
+				return false
+			}
+		}
+
+		for {
+			if err := ctx.Err(); err != nil {
+				send(pageOrError{err: err})
+				return
+			}
+
+			page, err := pager.fetchNextPage(url)
+			if err != nil {
+				send(pageOrError{err: err})
+				return
+			}
+
+			empty, err := page.IsEmpty()
+			if err != nil {
+				send(pageOrError{err: err})
+				return
+			}
+			if empty || !send(pageOrError{page: page}) {
+				return
+			}
+
+			url, err = page.NextPageURL()
+			if err != nil {
+				send(pageOrError{err: err})
+				return
+			}
+			if url == "" {
+				return
+			}
+		}
+	}(it.url)
+}
+
+// fail ends the iteration with err, which may be nil.
+func (it *PageIterator) fail(err error) bool {
+	it.err = err
+	it.done = true
+	it.page = nil
+	if it.stop != nil {
+		it.stop()
+		it.stop = nil
+	}
+	return false
+}
+
+// Page returns the page fetched by the last call to Next.
+func (it *PageIterator) Page() Page {
+	return it.page
+}
+
+// Err returns the error which ended the iteration, if any.
+func (it *PageIterator) Err() error {
+	return it.err
+}
+
+// Close stops the iteration. No more pages are fetched, and prefetching is
+// cancelled.
+func (it *PageIterator) Close() {
+	it.fail(it.err)
+}
+
+// Iterator streams the items of a paginated collection, fetching its pages
+// lazily. Only the current page is held in memory, and stopping the iteration
+// early avoids fetching the remaining pages.
+//
+//	it := pagination.NewIterator(servers.List(client, nil), servers.ExtractServers)
+//	defer it.Close()
+//	for it.Next() {
+//		server := it.Value().(servers.Server)
+//		...
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type Iterator struct {
+	pages   *PageIterator
+	extract reflect.Value
+	items   reflect.Value
+	index   int
+	err     error
+}
+
+var (
+	pageType  = reflect.TypeOf((*Page)(nil)).Elem()
+	errorType = reflect.TypeOf((*error)(nil)).Elem()
+)
+
+// NewIterator returns an Iterator over the items of the collection of pager.
+// extract is the function of the resource package which extracts the items of
+// a page, of type func(pagination.Page) ([]T, error), such as
+// servers.ExtractServers. Values returned by the Iterator are of type T.
+func NewIterator(pager Pager, extract interface{}) *Iterator {
+	it := &Iterator{
+		pages:   pager.Pages(),
+		extract: reflect.ValueOf(extract),
+	}
+
+	t := it.extract.Type()
+	if t.Kind() != reflect.Func || t.NumIn() != 1 || t.In(0) != pageType ||
+		t.NumOut() != 2 || t.Out(0).Kind() != reflect.Slice || t.Out(1) != errorType {
+		err := gophercloud.ErrUnexpectedType{}
+		err.Expected = "func(pagination.Page) ([]T, error)"
+		err.Actual = fmt.Sprintf("%T", extract)
+		it.err = err
+		it.pages.Close()
+	}
+
+	return it
+}
+
+// Next advances to the next item, fetching the next page if needed. It
+// returns false once there are no more items, or if an error occurred, which
+// is then returned by Err.
+func (it *Iterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+
+	for !it.items.IsValid() || it.index+1 >= it.items.Len() {
+		if !it.pages.Next() {
+			it.err = it.pages.Err()
+			it.items = reflect.Value{}
+			return false
+		}
+
+		out := it.extract.Call([]reflect.Value{reflect.ValueOf(it.pages.Page())})
+		if err, _ := out[1].Interface().(error); err != nil {
+			it.err = err
+			it.items = reflect.Value{}
+			it.pages.Close()
+			return false
+		}
+		it.items = out[0]
+		it.index = -1
+	}
+
+	it.index++
+	return true
+}
+
+// Value returns the item the Iterator is at. It must only be called after a
+// call to Next returned true.
+func (it *Iterator) Value() interface{} {
+	return it.items.Index(it.index).Interface()
+}
+
+// Err returns the error which ended the iteration, if any.
+func (it *Iterator) Err() error {
+	return it.err
+}
+
+// Close stops the iteration. No more pages are fetched.
+func (it *Iterator) Close() {
+	it.items = reflect.Value{}
+	it.pages.Close()
+}
@@ -1,6 +1,7 @@
 package pagination
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -45,6 +46,18 @@ type Pager struct {
 
 	// Headers supplies additional HTTP headers to populate on each paged request.
 	Headers map[string]string
+
+	// Context, if set, is used for each paged request instead of the
+	// ProviderClient's Context. Iteration stops with the context's error once
+	// it is done.
+	Context context.Context
+
+	// Prefetch, if positive, is the number of pages requested ahead of the
+	// page being processed, so that the next pages are fetched while the
+	// caller handles the current one. Pages are still handed out in order,
+	// and an error fetching a page is returned once the preceding pages have
+	// been handled.
+	Prefetch int
 }
 
 // NewPager constructs a manually-configured pager.
@@ -64,11 +77,13 @@ func (p Pager) WithPageCreator(createPage func(r PageResult) Page) Pager {
 		client:     p.client,
 		initialURL: p.initialURL,
 		createPage: createPage,
+		Context:    p.Context,
+		Prefetch:   p.Prefetch,
 	}
 }
 
 func (p Pager) fetchNextPage(url string) (Page, error) {
-	resp, err := Request(p.client, p.Headers, url)
+	resp, err := request(p.Context, p.client, p.Headers, url)
 	if err != nil {
 		return nil, err
 	}
@@ -84,40 +99,18 @@ func (p Pager) fetchNextPage(url string) (Page, error) {
 // EachPage iterates over each page returned by a Pager, yielding one at a time to a handler function.
 // Return "false" from the handler to prematurely stop iterating.
 func (p Pager) EachPage(handler func(Page) (bool, error)) error {
-	if p.Err != nil {
-		return p.Err
-	}
-	currentURL := p.initialURL
-	for {
-		currentPage, err := p.fetchNextPage(currentURL)
-		if err != nil {
-			return err
-		}
-
-		empty, err := currentPage.IsEmpty()
-		if err != nil {
-			return err
-		}
-		if empty {
-			return nil
-		}
-
-		ok, err := handler(currentPage)
+	pages := p.Pages()
+	defer pages.Close()
+	for pages.Next() {
+		ok, err := handler(pages.Page())
 		if err != nil {
 			return err
 		}
 		if !ok {
 			return nil
 		}
-
-		currentURL, err = currentPage.NextPageURL()
-		if err != nil {
-			return err
-		}
-		if currentURL == "" {
-			return nil
-		}
 	}
+	return pages.Err()
 }
 
 // AllPages returns all the pages from a `List` operation in a single page,
@@ -0,0 +1,117 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+	"github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestIteratorLinked(t *testing.T) {
+	pager := createLinked(t)
+	defer testhelper.TeardownHTTP()
+
+	it := pagination.NewIterator(pager, ExtractLinkedInts)
+	defer it.Close()
+
+	var actual []int
+	for it.Next() {
+		actual = append(actual, it.Value().(int))
+	}
+	testhelper.AssertNoErr(t, it.Err())
+	testhelper.CheckDeepEquals(t, []int{1, 2, 3, 4, 5, 6, 7, 8, 9}, actual)
+}
+
+func TestIteratorMarker(t *testing.T) {
+	pager := createMarkerPaged(t)
+	defer testhelper.TeardownHTTP()
+
+	it := pagination.NewIterator(pager, ExtractMarkerStrings)
+	defer it.Close()
+
+	var actual []string
+	for it.Next() {
+		actual = append(actual, it.Value().(string))
+	}
+	testhelper.AssertNoErr(t, it.Err())
+	testhelper.CheckDeepEquals(t, []string{"aaa", "bbb", "ccc", "ddd", "eee", "fff", "ggg", "hhh", "iii"}, actual)
+}
+
+func TestIteratorSingle(t *testing.T) {
+	pager := setupSinglePaged()
+	defer testhelper.TeardownHTTP()
+
+	it := pagination.NewIterator(pager, ExtractSingleInts)
+	defer it.Close()
+
+	var actual []int
+	for it.Next() {
+		actual = append(actual, it.Value().(int))
+	}
+	testhelper.AssertNoErr(t, it.Err())
+	testhelper.CheckDeepEquals(t, []int{1, 2, 3}, actual)
+}
+
+func TestIteratorEarlyTermination(t *testing.T) {
+	pager := createMarkerPaged(t)
+	defer testhelper.TeardownHTTP()
+
+	// Only the first page is requested: the handler of the test server
+	// reports requests for unexpected markers.
+	it := pagination.NewIterator(pager, ExtractMarkerStrings)
+	testhelper.AssertEquals(t, true, it.Next())
+	testhelper.AssertEquals(t, "aaa", it.Value())
+	it.Close()
+
+	testhelper.AssertEquals(t, false, it.Next())
+	testhelper.AssertNoErr(t, it.Err())
+}
+
+func TestIteratorContextCancelled(t *testing.T) {
+	pager := createLinked(t)
+	defer testhelper.TeardownHTTP()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	pager.Context = ctx
+
+	it := pagination.NewIterator(pager, ExtractLinkedInts)
+	count := 0
+	for it.Next() {
+		count++
+		if count == 2 {
+			cancel()
+		}
+	}
+	testhelper.AssertEquals(t, context.Canceled, it.Err())
+	testhelper.AssertEquals(t, 3, count)
+}
+
+func TestIteratorInvalidExtract(t *testing.T) {
+	pager := createLinked(t)
+	defer testhelper.TeardownHTTP()
+
+	it := pagination.NewIterator(pager, func(pagination.Page) (int, error) { return 0, nil })
+	testhelper.AssertEquals(t, false, it.Next())
+	if _, ok := it.Err().(gophercloud.ErrUnexpectedType); !ok {
+		t.Fatalf("expected ErrUnexpectedType, got %T: %v", it.Err(), it.Err())
+	}
+}
+
+func TestPageIterator(t *testing.T) {
+	pager := createLinked(t)
+	defer testhelper.TeardownHTTP()
+
+	pages := pager.Pages()
+	count := 0
+	for pages.Next() {
+		ints, err := ExtractLinkedInts(pages.Page())
+		testhelper.AssertNoErr(t, err)
+		testhelper.AssertEquals(t, 3, len(ints))
+		count++
+	}
+	testhelper.AssertNoErr(t, pages.Err())
+	testhelper.AssertEquals(t, 3, count)
+}
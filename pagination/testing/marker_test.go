@@ -1,6 +1,7 @@
 package testing
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"strings"
@@ -113,6 +114,23 @@ func TestEnumerateMarker(t *testing.T) {
 	testhelper.AssertEquals(t, callCount, 3)
 }
 
+func TestEnumerateMarkerContextCancelled(t *testing.T) {
+	pager := createMarkerPaged(t)
+	defer testhelper.TeardownHTTP()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	pager.Context = ctx
+
+	callCount := 0
+	err := pager.EachPage(func(page pagination.Page) (bool, error) {
+		callCount++
+		cancel()
+		return true, nil
+	})
+	testhelper.AssertEquals(t, context.Canceled, err)
+	testhelper.AssertEquals(t, 1, callCount)
+}
+
 func TestAllPagesMarker(t *testing.T) {
 	pager := createMarkerPaged(t)
 	defer testhelper.TeardownHTTP()
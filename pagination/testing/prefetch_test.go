@@ -0,0 +1,120 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+	"github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestEnumerateMarkerPrefetch(t *testing.T) {
+	pager := createMarkerPaged(t)
+	defer testhelper.TeardownHTTP()
+	pager.Prefetch = 2
+
+	var actual []string
+	err := pager.EachPage(func(page pagination.Page) (bool, error) {
+		items, err := ExtractMarkerStrings(page)
+		actual = append(actual, items...)
+		return true, err
+	})
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, []string{"aaa", "bbb", "ccc", "ddd", "eee", "fff", "ggg", "hhh", "iii"}, actual)
+}
+
+func TestAllPagesLinkedPrefetch(t *testing.T) {
+	pager := createLinked(t)
+	defer testhelper.TeardownHTTP()
+	pager.Prefetch = 1
+
+	page, err := pager.AllPages()
+	testhelper.AssertNoErr(t, err)
+
+	actual, err := ExtractLinkedInts(page)
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, []int{1, 2, 3, 4, 5, 6, 7, 8, 9}, actual)
+}
+
+func TestPrefetchFetchesWhileProcessing(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+
+	page2Requested := make(chan struct{})
+	testhelper.Mux.HandleFunc("/page1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{ "ints": [1, 2, 3], "links": { "next": "%s/page2" } }`, testhelper.Server.URL)
+	})
+	testhelper.Mux.HandleFunc("/page2", func(w http.ResponseWriter, r *http.Request) {
+		close(page2Requested)
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{ "ints": [4, 5, 6], "links": { "next": null } }`)
+	})
+
+	createPage := func(r pagination.PageResult) pagination.Page {
+		return LinkedPageResult{pagination.LinkedPageBase{PageResult: r}}
+	}
+	pager := pagination.NewPager(createClient(), testhelper.Server.URL+"/page1", createPage)
+	pager.Prefetch = 1
+
+	count := 0
+	err := pager.EachPage(func(page pagination.Page) (bool, error) {
+		if count == 0 {
+			select {
+			case <-page2Requested:
+			case <-time.After(5 * time.Second):
+				t.Fatal("the second page wasn't requested while handling the first one")
+			}
+		}
+		count++
+		return true, nil
+	})
+	testhelper.AssertNoErr(t, err)
+	testhelper.AssertEquals(t, 2, count)
+}
+
+func TestPrefetchError(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+
+	testhelper.Mux.HandleFunc("/page1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{ "ints": [1, 2, 3], "links": { "next": "%s/page2" } }`, testhelper.Server.URL)
+	})
+	testhelper.Mux.HandleFunc("/page2", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	createPage := func(r pagination.PageResult) pagination.Page {
+		return LinkedPageResult{pagination.LinkedPageBase{PageResult: r}}
+	}
+	pager := pagination.NewPager(createClient(), testhelper.Server.URL+"/page1", createPage)
+	pager.Prefetch = 3
+
+	it := pagination.NewIterator(pager, ExtractLinkedInts)
+	var actual []int
+	for it.Next() {
+		actual = append(actual, it.Value().(int))
+	}
+	testhelper.CheckDeepEquals(t, []int{1, 2, 3}, actual)
+	if _, ok := it.Err().(gophercloud.ErrDefault500); !ok {
+		t.Fatalf("expected ErrDefault500, got %T: %v", it.Err(), it.Err())
+	}
+}
+
+func TestPrefetchEarlyTermination(t *testing.T) {
+	pager := createLinked(t)
+	defer testhelper.TeardownHTTP()
+	pager.Prefetch = 1
+
+	count := 0
+	err := pager.EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		return false, nil
+	})
+	testhelper.AssertNoErr(t, err)
+	testhelper.AssertEquals(t, 1, count)
+}
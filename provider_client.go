@@ -2,12 +2,14 @@ package gophercloud
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"io/ioutil"
 	"net/http"
 	"strings"
 	"sync"
+	"time"
 )
 
 // DefaultUserAgent is the default User-Agent string set in the request header.
@@ -60,6 +62,11 @@ type ProviderClient struct {
 	// its constituent services.
 	EndpointLocator EndpointLocator
 
+	// EndpointOverrides maps service types, such as "compute", to the
+	// endpoint URLs used by the ServiceClients of these types instead of
+	// the ones found in the service catalog, in every region.
+	EndpointOverrides map[string]string
+
 	// HTTPClient allows users to interject arbitrary http, https, or other transit behaviors.
 	HTTPClient http.Client
 
@@ -71,6 +78,60 @@ type ProviderClient struct {
 	// authentication functions for different Identity service versions.
 	ReauthFunc func() error
 
+	// ReauthContextFunc, if set along with ReauthFunc, is used instead of
+	// ReauthFunc. It receives the context of the request which triggered the
+	// reauthentication, so that cancelling that request also aborts the
+	// authentication request.
+	ReauthContextFunc func(ctx context.Context) error
+
+	// Context is the context passed to every HTTP request issued by this
+	// client, including reauthentication requests. Cancelling it aborts any
+	// in-flight and pending requests. It can be overridden for a single
+	// request by setting RequestOpts.Context.
+	Context context.Context
+
+	// RetryPolicy, if set, is used to retry requests that failed with a
+	// transient error such as a 429 or 503 response code.
+	RetryPolicy *RetryPolicy
+
+	// Hooks are called, in order, to observe the requests issued by this
+	// client. See DebugLogger for a built-in hook.
+	Hooks []RequestHook
+
+	// TokenRefreshMargin, if positive, makes the client reauthenticate before
+	// issuing a request when its token expires within this margin, instead of
+	// waiting for a 401 response. It only applies if the expiry of the token
+	// is known, see SetTokenAndAuthResult.
+	TokenRefreshMargin time.Duration
+
+	// TokenCache, if set, is used when authenticating against Identity V3 to
+	// reuse a still-valid token obtained earlier, possibly by another
+	// process, and to save newly issued tokens.
+	TokenCache TokenCache
+
+	// GlobalRequestID, if set, is sent as the X-OpenStack-Request-ID header
+	// of every request, so that the actions of services on behalf of the
+	// client can be correlated in their logs. See NewGlobalRequestID.
+	GlobalRequestID string
+
+	// RateLimiter, if set, limits the rate and concurrency of the requests
+	// issued by this client, including retries and reauthentication.
+	RateLimiter *RateLimiter
+
+	// Metrics, if set, receives the metrics of every request issued by this
+	// client.
+	Metrics MetricsObserver
+
+	// authResult and tokenExpiresAt describe the token in TokenID, if known.
+	authResult     AuthResult
+	tokenExpiresAt time.Time
+
+	// serviceCatalog is the catalog returned along with the token, if known.
+	serviceCatalog *ServiceCatalog
+
+	// throwaway is set on the copies of a client used to reauthenticate it.
+	throwaway bool
+
 	mut *sync.RWMutex
 
 	reauthmut *reauthlock
@@ -81,10 +142,19 @@ type reauthlock struct {
 	reauthing bool
 }
 
+// AuthResult is the result of the request that was used to obtain the token of
+// a ProviderClient. It is implemented by the CreateResult types of the Identity
+// tokens packages, and gives access to the catalog, roles and scope of the
+// token.
+type AuthResult interface {
+	ExtractTokenID() (string, error)
+	ExtractTokenExpiresAt() (time.Time, error)
+}
+
 // AuthenticatedHeaders returns a map of HTTP headers that are common for all
 // authenticated service requests.
 func (client *ProviderClient) AuthenticatedHeaders() (m map[string]string) {
-	if client.reauthmut != nil {
+	if client.reauthmut != nil && !client.throwaway {
 		client.reauthmut.RLock()
 		if client.reauthmut.reauthing {
 			client.reauthmut.RUnlock()
@@ -109,7 +179,7 @@ func (client *ProviderClient) UseTokenLock() {
 // Token safely reads the value of the auth token from the ProviderClient. Applications should
 // call this method to access the token instead of the TokenID field
 func (client *ProviderClient) Token() string {
-	if client.mut != nil {
+	if client.mut != nil && !client.throwaway {
 		client.mut.RLock()
 		defer client.mut.RUnlock()
 	}
@@ -119,11 +189,113 @@ func (client *ProviderClient) Token() string {
 // SetToken safely sets the value of the auth token in the ProviderClient. Applications may
 // use this method in a custom ReauthFunc
 func (client *ProviderClient) SetToken(t string) {
-	if client.mut != nil {
+	if client.mut != nil && !client.throwaway {
 		client.mut.Lock()
 		defer client.mut.Unlock()
 	}
 	client.TokenID = t
+	client.authResult = nil
+	client.tokenExpiresAt = time.Time{}
+}
+
+// SetTokenAndAuthResult safely sets the auth token from the result of an
+// authentication request, keeping the result and the expiry of the token.
+func (client *ProviderClient) SetTokenAndAuthResult(r AuthResult) error {
+	tokenID, err := r.ExtractTokenID()
+	if err != nil {
+		return err
+	}
+	expiresAt, err := r.ExtractTokenExpiresAt()
+	if err != nil {
+		return err
+	}
+
+	if client.mut != nil && !client.throwaway {
+		client.mut.Lock()
+		defer client.mut.Unlock()
+	}
+	client.TokenID = tokenID
+	client.authResult = r
+	client.tokenExpiresAt = expiresAt
+	return nil
+}
+
+// CopyTokenFrom copies the token, along with its AuthResult, expiry and
+// service catalog, from other. It is meant to be called by a ReauthFunc,
+// during which Reauthenticate already holds the token lock, so it doesn't take
+// the lock itself.
+func (client *ProviderClient) CopyTokenFrom(other *ProviderClient) {
+	client.TokenID = other.TokenID
+	client.authResult = other.authResult
+	client.tokenExpiresAt = other.tokenExpiresAt
+	client.serviceCatalog = other.serviceCatalog
+}
+
+// SetServiceCatalog safely sets the service catalog returned along with the
+// current token. It is meant to be called by provider implementations when
+// authenticating.
+func (client *ProviderClient) SetServiceCatalog(catalog *ServiceCatalog) {
+	if client.mut != nil && !client.throwaway {
+		client.mut.Lock()
+		defer client.mut.Unlock()
+	}
+	client.serviceCatalog = catalog
+}
+
+// ServiceCatalog safely returns the service catalog returned along with the
+// current token, or nil if it is unknown. It is updated when the client
+// reauthenticates, and must not be modified.
+func (client *ProviderClient) ServiceCatalog() *ServiceCatalog {
+	if client.mut != nil && !client.throwaway {
+		client.mut.RLock()
+		defer client.mut.RUnlock()
+	}
+	return client.serviceCatalog
+}
+
+// GetAuthResult safely returns the result of the authentication request that
+// yielded the current token, or nil if it is unknown, e.g. because the token
+// was set with SetToken.
+func (client *ProviderClient) GetAuthResult() AuthResult {
+	if client.mut != nil && !client.throwaway {
+		client.mut.RLock()
+		defer client.mut.RUnlock()
+	}
+	return client.authResult
+}
+
+// TokenExpiresAt safely returns the expiry of the current token. It is zero
+// if the expiry is unknown.
+func (client *ProviderClient) TokenExpiresAt() time.Time {
+	if client.mut != nil && !client.throwaway {
+		client.mut.RLock()
+		defer client.mut.RUnlock()
+	}
+	return client.tokenExpiresAt
+}
+
+// SetThrowaway marks the client as a throwaway copy of another client, used
+// by a ReauthFunc to obtain a new token. A throwaway client doesn't use the
+// token lock it shares with the original client, which is held during
+// reauthentication.
+func (client *ProviderClient) SetThrowaway(v bool) {
+	client.throwaway = v
+}
+
+// IsThrowaway reports whether the client is a throwaway copy of another
+// client, see SetThrowaway.
+func (client *ProviderClient) IsThrowaway() bool {
+	return client.throwaway
+}
+
+// tokenNeedsRefresh reports whether the token expires within the
+// TokenRefreshMargin and can be refreshed.
+func (client *ProviderClient) tokenNeedsRefresh() bool {
+	if client.ReauthFunc == nil || client.TokenRefreshMargin <= 0 {
+		return false
+	}
+	expiresAt := client.TokenExpiresAt()
+	return !expiresAt.IsZero() && time.Until(expiresAt) < client.TokenRefreshMargin
 }
 
 //Reauthenticate calls client.ReauthFunc in a thread-safe way. If this is
@@ -132,12 +304,18 @@ func (client *ProviderClient) SetToken(t string) {
 //reauthenticated in the meantime. If no previous token is known, an empty
 //string should be passed instead to force unconditional reauthentication.
 func (client *ProviderClient) Reauthenticate(previousToken string) (err error) {
+	return client.ReauthenticateContext(client.Context, previousToken)
+}
+
+// ReauthenticateContext is like Reauthenticate, but passes ctx to
+// client.ReauthContextFunc if it is set.
+func (client *ProviderClient) ReauthenticateContext(ctx context.Context, previousToken string) (err error) {
 	if client.ReauthFunc == nil {
 		return nil
 	}
 
-	if client.mut == nil {
-		return client.ReauthFunc()
+	if client.mut == nil || client.throwaway {
+		return client.reauth(ctx)
 	}
 	client.mut.Lock()
 	defer client.mut.Unlock()
@@ -147,7 +325,7 @@ func (client *ProviderClient) Reauthenticate(previousToken string) (err error) {
 	client.reauthmut.Unlock()
 
 	if previousToken == "" || client.TokenID == previousToken {
-		err = client.ReauthFunc()
+		err = client.reauth(ctx)
 	}
 
 	client.reauthmut.Lock()
@@ -156,6 +334,13 @@ func (client *ProviderClient) Reauthenticate(previousToken string) (err error) {
 	return
 }
 
+func (client *ProviderClient) reauth(ctx context.Context) error {
+	if client.ReauthContextFunc != nil {
+		return client.ReauthContextFunc(ctx)
+	}
+	return client.ReauthFunc()
+}
+
 // RequestOpts customizes the behavior of the provider.Request() method.
 type RequestOpts struct {
 	// JSONBody, if provided, will be encoded as JSON and used as the body of the HTTP request. The
@@ -178,13 +363,115 @@ type RequestOpts struct {
 	// ErrorContext specifies the resource error type to return if an error is encountered.
 	// This lets resources override default error messages based on the response status code.
 	ErrorContext error
+	// Context, if provided, is used for this request instead of ProviderClient.Context.
+	Context context.Context
+	// GlobalRequestID, if provided, is used for this request instead of
+	// ProviderClient.GlobalRequestID.
+	GlobalRequestID string
+
+	// serviceType and microversion are set by the ServiceClient issuing the
+	// request, to be reported to hooks.
+	serviceType  string
+	microversion string
 }
 
 var applicationJSON = "application/json"
 
+// requestState tracks a single call to Request across its retries and
+// reauthentication.
+type requestState struct {
+	// attempt is the number of retries made so far.
+	attempt int
+
+	// reauthenticated is true once the client has reauthenticated.
+	reauthenticated bool
+
+	// reauths is the number of times the client has reauthenticated.
+	reauths int
+
+	// statusCode is the status code of the last response received.
+	statusCode int
+}
+
+func (state *requestState) info(method, url string, options *RequestOpts) RequestInfo {
+	return RequestInfo{
+		ServiceType:     options.serviceType,
+		Microversion:    options.microversion,
+		Method:          method,
+		URL:             url,
+		Attempt:         state.attempt,
+		Reauthenticated: state.reauthenticated,
+	}
+}
+
 // Request performs an HTTP request using the ProviderClient's current HTTPClient. An authentication
 // header will automatically be provided.
 func (client *ProviderClient) Request(method, url string, options *RequestOpts) (*http.Response, error) {
+	state := &requestState{}
+	start := time.Now()
+
+	// Refresh a token about to expire. Should this fail, the request is
+	// still attempted since the current token may be valid for a while.
+	if client.tokenNeedsRefresh() {
+		ctx := options.Context
+		if ctx == nil {
+			ctx = client.Context
+		}
+		err := client.ReauthenticateContext(ctx, client.Token())
+		state.reauthenticated = true
+		state.reauths++
+		client.onReauthHooks(state.info(method, url, options), err)
+	}
+
+	resp, err := client.doRequest(method, url, options, state)
+	if err != nil {
+		client.onErrorHooks(state.info(method, url, options), err)
+	}
+	if client.Metrics != nil {
+		client.Metrics.ObserveRequest(RequestMetrics{
+			ServiceType: options.serviceType,
+			Method:      method,
+			URLTemplate: URLTemplate(options.serviceType, url),
+			StatusCode:  state.statusCode,
+			Duration:    time.Since(start),
+			Retries:     state.attempt,
+			Reauths:     state.reauths,
+			Err:         err,
+		})
+	}
+	return resp, err
+}
+
+// retry waits according to the client's RetryPolicy and issues the request
+// again. The caller must have checked that the policy allows a retry.
+func (client *ProviderClient) retry(ctx context.Context, method, url string, options *RequestOpts, state *requestState, retryAfter string) (*http.Response, error) {
+	if err := sleepContext(ctx, client.RetryPolicy.delay(state.attempt, retryAfter)); err != nil {
+		return nil, err
+	}
+	if options.RawBody != nil {
+		if seeker, ok := options.RawBody.(io.Seeker); ok {
+			if _, err := seeker.Seek(0, io.SeekStart); err != nil {
+				return nil, err
+			}
+		}
+	}
+	state.attempt++
+	return client.doRequest(method, url, options, state)
+}
+
+// canRetry reports whether the client's RetryPolicy allows the request to be
+// retried after the given failure.
+func (client *ProviderClient) canRetry(ctx context.Context, method string, options *RequestOpts, state *requestState, statusCode int, err error) bool {
+	if client.RetryPolicy == nil {
+		return false
+	}
+	if ctx != nil && ctx.Err() != nil {
+		return false
+	}
+	return client.RetryPolicy.shouldRetry(state.attempt, options, method, statusCode, err)
+}
+
+func (client *ProviderClient) doRequest(method, url string, options *RequestOpts, state *requestState) (*http.Response, error) {
 	var body io.Reader
 	var contentType *string
 
@@ -213,6 +500,13 @@ func (client *ProviderClient) Request(method, url string, options *RequestOpts)
 	if err != nil {
 		return nil, err
 	}
+	ctx := options.Context
+	if ctx == nil {
+		ctx = client.Context
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
 
 	// Populate the request headers. Apply options.MoreHeaders last, to give the caller the chance to
 	// modify or omit any header.
@@ -224,6 +518,12 @@ func (client *ProviderClient) Request(method, url string, options *RequestOpts)
 	// Set the User-Agent header
 	req.Header.Set("User-Agent", client.UserAgent.Join())
 
+	if id := options.GlobalRequestID; id != "" {
+		req.Header.Set("X-OpenStack-Request-ID", id)
+	} else if client.GlobalRequestID != "" {
+		req.Header.Set("X-OpenStack-Request-ID", client.GlobalRequestID)
+	}
+
 	if options.MoreHeaders != nil {
 		for k, v := range options.MoreHeaders {
 			if v != "" {
@@ -244,11 +544,22 @@ func (client *ProviderClient) Request(method, url string, options *RequestOpts)
 
 	prereqtok := req.Header.Get("X-Auth-Token")
 
-	// Issue the request.
+	// Wait for the rate limiter, then issue the request.
+	release, err := client.RateLimiter.wait(ctx, options.serviceType, method)
+	if err != nil {
+		return nil, err
+	}
+	client.beforeRequestHooks(state.info(method, url, options), req)
 	resp, err := client.HTTPClient.Do(req)
+	release()
 	if err != nil {
+		if client.canRetry(ctx, method, options, state, 0, err) {
+			return client.retry(ctx, method, url, options, state, "")
+		}
 		return nil, err
 	}
+	state.statusCode = resp.StatusCode
+	client.afterResponseHooks(state.info(method, url, options), req, resp)
 
 	// Allow default OkCodes if none explicitly set
 	if options.OkCodes == nil {
@@ -273,6 +584,9 @@ func (client *ProviderClient) Request(method, url string, options *RequestOpts)
 			Expected: options.OkCodes,
 			Actual:   resp.StatusCode,
 			Body:     body,
+
+			Fault:     ParseFault(body),
+			RequestID: RequestIDFromHeader(resp.Header),
 		}
 
 		errType := options.ErrorContext
@@ -284,18 +598,26 @@ func (client *ProviderClient) Request(method, url string, options *RequestOpts)
 			}
 		case http.StatusUnauthorized:
 			if client.ReauthFunc != nil {
-				err = client.Reauthenticate(prereqtok)
+				err = client.ReauthenticateContext(ctx, prereqtok)
+				state.reauthenticated = true
+				state.reauths++
+				client.onReauthHooks(state.info(method, url, options), err)
 				if err != nil {
 					e := &ErrUnableToReauthenticate{}
 					e.ErrOriginal = respErr
 					return nil, e
 				}
+				// Don't retry the original request if the caller gave up
+				// while we were reauthenticating.
+				if ctx != nil && ctx.Err() != nil {
+					return nil, ctx.Err()
+				}
 				if options.RawBody != nil {
 					if seeker, ok := options.RawBody.(io.Seeker); ok {
 						seeker.Seek(0, 0)
 					}
 				}
-				resp, err = client.Request(method, url, options)
+				resp, err = client.doRequest(method, url, options, state)
 				if err != nil {
 					switch err.(type) {
 					case *ErrUnexpectedResponseCode:
@@ -355,6 +677,10 @@ func (client *ProviderClient) Request(method, url string, options *RequestOpts)
 			err = respErr
 		}
 
+		if client.canRetry(ctx, method, options, state, resp.StatusCode, err) {
+			return client.retry(ctx, method, url, options, state, resp.Header.Get("Retry-After"))
+		}
+
 		return resp, err
 	}
 
@@ -0,0 +1,162 @@
+package gophercloud
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RateLimit is the rate of a token bucket: on average Rate requests per second
+// are allowed, with bursts of up to Burst requests.
+type RateLimit struct {
+	// Rate is the number of requests allowed per second. Zero or less means
+	// no limit.
+	Rate float64
+
+	// Burst is the number of requests which can be issued at once after a
+	// quiet period. Defaults to 1.
+	Burst int
+}
+
+func (l RateLimit) burst() float64 {
+	if l.Burst > 0 {
+		return float64(l.Burst)
+	}
+	return 1
+}
+
+// RateLimitKey designates the requests a RateLimit applies to. An empty
+// ServiceType or Method matches any service type or method.
+type RateLimitKey struct {
+	// ServiceType is the type of the ServiceClient issuing the request, such
+	// as "compute" or "network".
+	ServiceType string
+
+	// Method is the HTTP method of the request, such as "POST".
+	Method string
+}
+
+// RateLimiter limits the rate and concurrency of the requests issued by a
+// ProviderClient and its ServiceClients, so that many goroutines sharing a
+// client stay under the limits of the cloud. Set it on
+// ProviderClient.RateLimiter to enable it; a nil RateLimiter disables it.
+//
+// Each request is limited by the most specific RateLimit of Limits matching
+// it, looked up by service type and method, then by service type alone, then
+// by method alone, and finally under the zero RateLimitKey. Requests using
+// the same RateLimit share a token bucket. Requests matching no RateLimit are
+// only subject to MaxConcurrent.
+//
+//	provider.RateLimiter = &gophercloud.RateLimiter{
+//		Limits: map[gophercloud.RateLimitKey]gophercloud.RateLimit{
+//			{ServiceType: "compute"}:                 {Rate: 10, Burst: 20},
+//			{ServiceType: "compute", Method: "POST"}: {Rate: 1},
+//		},
+//		MaxConcurrent: 8,
+//	}
+//
+// Retries and reauthentication requests are limited too. A RateLimiter must
+// not be modified or copied once in use.
+type RateLimiter struct {
+	// Limits holds the token bucket parameters of the requests, by service
+	// type and method.
+	Limits map[RateLimitKey]RateLimit
+
+	// MaxConcurrent, if positive, is the maximum number of requests awaiting
+	// a response at any time.
+	MaxConcurrent int
+
+	mu      sync.Mutex
+	buckets map[RateLimitKey]*tokenBucket
+	slots   chan struct{}
+}
+
+type tokenBucket struct {
+	limit  RateLimit
+	tokens float64
+	last   time.Time
+}
+
+// reserve takes a token from the bucket at time now, and returns how long to
+// wait before it can be used.
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	b.tokens += now.Sub(b.last).Seconds() * b.limit.Rate
+	if burst := b.limit.burst(); b.tokens > burst {
+		b.tokens = burst
+	}
+	b.last = now
+
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.limit.Rate * float64(time.Second))
+}
+
+// lookup returns the key of the RateLimit applying to the requests of the
+// given service type and method.
+func (l *RateLimiter) lookup(serviceType, method string) (RateLimitKey, bool) {
+	for _, key := range []RateLimitKey{
+		{ServiceType: serviceType, Method: method},
+		{ServiceType: serviceType},
+		{Method: method},
+		{},
+	} {
+		if limit, ok := l.Limits[key]; ok && limit.Rate > 0 {
+			return key, true
+		}
+	}
+	return RateLimitKey{}, false
+}
+
+// wait blocks until a request of the given service type and method may be
+// issued, or until ctx is done. On success, the returned function must be
+// called once the response is received. A nil RateLimiter never blocks.
+func (l *RateLimiter) wait(ctx context.Context, serviceType, method string) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+
+	l.mu.Lock()
+	var delay time.Duration
+	if key, ok := l.lookup(serviceType, method); ok {
+		if l.buckets == nil {
+			l.buckets = make(map[RateLimitKey]*tokenBucket)
+		}
+		b, ok := l.buckets[key]
+		if !ok {
+			limit := l.Limits[key]
+			b = &tokenBucket{limit: limit, tokens: limit.burst(), last: time.Now()}
+			l.buckets[key] = b
+		}
+		delay = b.reserve(time.Now())
+	}
+	if l.MaxConcurrent > 0 && l.slots == nil {
+		l.slots = make(chan struct{}, l.MaxConcurrent)
+	}
+	slots := l.slots
+	l.mu.Unlock()
+
+	if delay > 0 {
+		if err := sleepContext(ctx, delay); err != nil {
+			return nil, err
+		}
+	}
+
+	if slots == nil {
+		return func() {}, nil
+	}
+
+	var done <-chan struct{}
+	if ctx != nil {
+		done = ctx.Done()
+	}
+	select {
+	case slots <- struct{}{}:
+	case <-done:
+		return nil, ctx.Err()
+	}
+
+	var once sync.Once
+	return func() { once.Do(func() { <-slots }) }, nil
+}
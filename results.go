@@ -62,6 +62,26 @@ func (r Result) ExtractInto(to interface{}) error {
 	return err
 }
 
+// RequestID returns the ID the service assigned to the request, as given by
+// the X-Openstack-Request-Id or X-Compute-Request-Id response header. It is
+// empty if the service didn't return one.
+func (r Result) RequestID() string {
+	return RequestIDFromHeader(r.Header)
+}
+
+// ParseResponse returns the headers of the response to a request issued by a
+// ServiceClient, along with its error. Request functions use it to fill the
+// Header of their Result:
+//
+//	resp, err := client.Get(getURL(client, id), &r.Body, nil)
+//	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+func ParseResponse(resp *http.Response, err error) (io.ReadCloser, http.Header, error) {
+	if resp == nil {
+		return nil, nil, err
+	}
+	return resp.Body, resp.Header, err
+}
+
 func (r Result) extractIntoPtr(to interface{}, label string) error {
 	if label == "" {
 		return r.ExtractInto(&to)
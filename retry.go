@@ -0,0 +1,171 @@
+package gophercloud
+
+import (
+	"context"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// DefaultRetryMaxAttempts is the number of attempts, including the first
+	// one, made by a RetryPolicy which doesn't set MaxAttempts.
+	DefaultRetryMaxAttempts = 3
+
+	// DefaultRetryBaseDelay is the delay before the first retry of a
+	// RetryPolicy which doesn't set BaseDelay.
+	DefaultRetryBaseDelay = 500 * time.Millisecond
+
+	// DefaultRetryMaxDelay is the upper bound of the computed backoff of a
+	// RetryPolicy which doesn't set MaxDelay.
+	DefaultRetryMaxDelay = 30 * time.Second
+)
+
+// RetryPolicy describes how ProviderClient.Request retries failed requests.
+// Set it on ProviderClient.RetryPolicy to enable retries; a nil policy
+// disables them.
+//
+// The delay between attempts grows exponentially from BaseDelay up to
+// MaxDelay, with random jitter. If the response carries a Retry-After header,
+// its value is used instead.
+//
+// Requests whose RawBody does not implement io.Seeker are never retried, since
+// the body cannot be sent a second time. Neither are requests whose context is
+// done. If rewinding the body fails, the error is returned instead of retrying.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts, including the first one.
+	// Defaults to DefaultRetryMaxAttempts.
+	MaxAttempts int
+
+	// BaseDelay is the delay before the first retry. Defaults to
+	// DefaultRetryBaseDelay.
+	BaseDelay time.Duration
+
+	// MaxDelay caps the computed exponential backoff. It does not apply to a
+	// delay requested by the service through Retry-After. Defaults to
+	// DefaultRetryMaxDelay.
+	MaxDelay time.Duration
+
+	// ShouldRetry decides if a failed attempt is retried. statusCode is 0 if
+	// no response was received, in which case err is the transport error.
+	// Defaults to DefaultShouldRetry.
+	ShouldRetry func(method string, statusCode int, err error) bool
+}
+
+// DefaultShouldRetry retries idempotent requests (GET, HEAD, OPTIONS, PUT and
+// DELETE) which failed with a 429, 500, 502, 503 or 504 response code or with
+// a transport error.
+func DefaultShouldRetry(method string, statusCode int, err error) bool {
+	switch method {
+	case "GET", "HEAD", "OPTIONS", "PUT", "DELETE":
+	default:
+		return false
+	}
+
+	switch statusCode {
+	case 0:
+		return err != nil
+	case 429, http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+
+	return false
+}
+
+func (p *RetryPolicy) maxAttempts() int {
+	if p.MaxAttempts > 0 {
+		return p.MaxAttempts
+	}
+	return DefaultRetryMaxAttempts
+}
+
+// shouldRetry reports whether the attempt-th attempt (starting at 0) of a
+// request may be followed by another one.
+func (p *RetryPolicy) shouldRetry(attempt int, options *RequestOpts, method string, statusCode int, err error) bool {
+	if attempt+1 >= p.maxAttempts() {
+		return false
+	}
+	if options.RawBody != nil {
+		if _, ok := options.RawBody.(io.Seeker); !ok {
+			return false
+		}
+	}
+	if p.ShouldRetry != nil {
+		return p.ShouldRetry(method, statusCode, err)
+	}
+	return DefaultShouldRetry(method, statusCode, err)
+}
+
+// delay returns how long to wait before the retry following the attempt-th
+// attempt. A non-empty retryAfter header value takes precedence over the
+// computed backoff.
+func (p *RetryPolicy) delay(attempt int, retryAfter string) time.Duration {
+	if d, ok := parseRetryAfter(retryAfter); ok {
+		return d
+	}
+
+	base := p.BaseDelay
+	if base <= 0 {
+		base = DefaultRetryBaseDelay
+	}
+	max := p.MaxDelay
+	if max <= 0 {
+		max = DefaultRetryMaxDelay
+	}
+
+	d := base
+	for i := 0; i < attempt && d < max; i++ {
+		d *= 2
+	}
+	if d > max {
+		d = max
+	}
+
+	// Use "equal jitter": wait at least half of the backoff, plus a random
+	// share of the other half.
+	half := d / 2
+	return half + time.Duration(rand.Int63n(int64(d-half)+1))
+}
+
+// parseRetryAfter parses a Retry-After header value, which is either a number
+// of seconds or an HTTP date.
+func parseRetryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if s, err := strconv.Atoi(v); err == nil {
+		if s < 0 {
+			return 0, false
+		}
+		return time.Duration(s) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		d := time.Until(t)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+	return 0, false
+}
+
+// sleepContext waits for d, returning early with the context's error if ctx
+// is done first. A nil ctx is never done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if ctx == nil {
+		time.Sleep(d)
+		return nil
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
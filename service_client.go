@@ -29,9 +29,30 @@ type ServiceClient struct {
 	// The microversion of the service to use. Set this to use a particular microversion.
 	Microversion string
 
+	// MinMicroversion and MaxMicroversion are the range of microversions
+	// supported by the service. They are recorded by
+	// utils.NegotiateMicroversion and checked by RequireMicroversion.
+	MinMicroversion string
+	MaxMicroversion string
+
 	// MoreHeaders allows users (or Gophercloud) to set service-wide headers on requests. Put another way,
 	// values set in this field will be set on all the HTTP requests the service client sends.
 	MoreHeaders map[string]string
+
+	// GlobalRequestID, if set, is used for the requests of this service client
+	// instead of ProviderClient.GlobalRequestID.
+	GlobalRequestID string
+}
+
+// WithGlobalRequestID returns a copy of the service client which sends id as
+// the global request ID of its requests. It sets the global request ID of a
+// single call:
+//
+//	server, err := servers.Create(client.WithGlobalRequestID(id), opts).Extract()
+func (client *ServiceClient) WithGlobalRequestID(id string) *ServiceClient {
+	c := *client
+	c.GlobalRequestID = id
+	return &c
 }
 
 // ResourceBaseURL returns the base URL of any resources used by this service. It MUST end with a /.
@@ -127,24 +148,37 @@ func (client *ServiceClient) setMicroversionHeader(opts *RequestOpts) {
 		opts.MoreHeaders["X-OpenStack-Nova-API-Version"] = client.Microversion
 	case "sharev2":
 		opts.MoreHeaders["X-OpenStack-Manila-API-Version"] = client.Microversion
-	case "volume":
+	case "volume", "volumev2", "volumev3":
 		opts.MoreHeaders["X-OpenStack-Volume-API-Version"] = client.Microversion
 	}
 
-	if client.Type != "" {
+	switch client.Type {
+	case "":
+	case "volumev2", "volumev3":
+		// The versioned catalog types of Cinder aren't valid service types.
+		opts.MoreHeaders["OpenStack-API-Version"] = "volume " + client.Microversion
+	default:
 		opts.MoreHeaders["OpenStack-API-Version"] = client.Type + " " + client.Microversion
 	}
 }
 
 // Request carries out the HTTP operation for the service client
 func (client *ServiceClient) Request(method, url string, options *RequestOpts) (*http.Response, error) {
+	if options == nil {
+		options = new(RequestOpts)
+	}
 	if len(client.MoreHeaders) > 0 {
-		if options == nil {
-			options = new(RequestOpts)
+		if options.MoreHeaders == nil {
+			options.MoreHeaders = make(map[string]string)
 		}
 		for k, v := range client.MoreHeaders {
 			options.MoreHeaders[k] = v
 		}
 	}
+	if options.GlobalRequestID == "" {
+		options.GlobalRequestID = client.GlobalRequestID
+	}
+	options.serviceType = client.Type
+	options.microversion = client.Microversion
 	return client.ProviderClient.Request(method, url, options)
 }
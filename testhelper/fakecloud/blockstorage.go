@@ -0,0 +1,156 @@
+package fakecloud
+
+import (
+	"net/http"
+	"time"
+)
+
+// cinderTimeFormat is the format of the timestamps in Cinder responses.
+const cinderTimeFormat = "2006-01-02T15:04:05.000000"
+
+type volumeRequest struct {
+	Volume struct {
+		Size             *int              `json:"size"`
+		Name             *string           `json:"name"`
+		Description      *string           `json:"description"`
+		AvailabilityZone string            `json:"availability_zone"`
+		VolumeType       string            `json:"volume_type"`
+		SnapshotID       string            `json:"snapshot_id"`
+		SourceVolID      string            `json:"source_volid"`
+		ImageID          string            `json:"imageRef"`
+		Metadata         map[string]string `json:"metadata"`
+		Multiattach      bool              `json:"multiattach"`
+	} `json:"volume"`
+}
+
+func (c *Cloud) handleVolume(w http.ResponseWriter, r *http.Request) {
+	t := c.authenticate(w, r)
+	if t == nil {
+		return
+	}
+
+	path := splitPath(r.URL.Path, "/volume/v3")
+	if len(path) < 2 || path[1] != "volumes" {
+		http.NotFound(w, r)
+		return
+	}
+	if path[0] != t.project.id {
+		writeFault(w, http.StatusForbidden, "forbidden", "Policy doesn't allow access to project "+path[0]+".")
+		return
+	}
+	path = path[2:]
+
+	if len(path) == 0 || (len(path) == 1 && path[0] == "detail") {
+		switch r.Method {
+		case "GET":
+			q := r.URL.Query()
+			volumes := c.volumes.list(func(v map[string]interface{}) bool {
+				return v["os-vol-tenant-attr:tenant_id"] == t.project.id && matchQuery(v, q, "name", "status", "bootable")
+			})
+			writeJSON(w, http.StatusOK, c.paginate(r, "volumes", volumes))
+		case "POST":
+			if len(path) != 0 {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+				return
+			}
+			c.createVolume(w, r, t)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+		return
+	}
+
+	v, ok := c.volumes.get(path[0])
+	if !ok || v["os-vol-tenant-attr:tenant_id"] != t.project.id || len(path) > 1 {
+		writeFault(w, http.StatusNotFound, "itemNotFound", "Volume "+path[0]+" could not be found.")
+		return
+	}
+
+	switch r.Method {
+	case "GET":
+		writeJSON(w, http.StatusOK, map[string]interface{}{"volume": v})
+	case "PUT":
+		var req volumeRequest
+		if err := readJSON(r, &req); err != nil {
+			writeFault(w, http.StatusBadRequest, "badRequest", err.Error())
+			return
+		}
+		if req.Volume.Name != nil {
+			v["name"] = *req.Volume.Name
+		}
+		if req.Volume.Description != nil {
+			v["description"] = *req.Volume.Description
+		}
+		if req.Volume.Metadata != nil {
+			v["metadata"] = req.Volume.Metadata
+		}
+		v["updated_at"] = time.Now().UTC().Format(cinderTimeFormat)
+		writeJSON(w, http.StatusOK, map[string]interface{}{"volume": v})
+	case "DELETE":
+		c.volumes.remove(v["id"].(string))
+		w.WriteHeader(http.StatusAccepted)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (c *Cloud) createVolume(w http.ResponseWriter, r *http.Request, t *token) {
+	var req volumeRequest
+	if err := readJSON(r, &req); err != nil {
+		writeFault(w, http.StatusBadRequest, "badRequest", err.Error())
+		return
+	}
+	opts := req.Volume
+	if opts.Size == nil || *opts.Size <= 0 {
+		writeFault(w, http.StatusBadRequest, "badRequest", "Invalid input for field/attribute size.")
+		return
+	}
+
+	id := newUUID()
+	now := time.Now().UTC().Format(cinderTimeFormat)
+	v := map[string]interface{}{
+		"id":                           id,
+		"name":                         "",
+		"description":                  "",
+		"size":                         *opts.Size,
+		"status":                       "available",
+		"availability_zone":            "nova",
+		"created_at":                   now,
+		"updated_at":                   now,
+		"volume_type":                  "lvmdriver-1",
+		"snapshot_id":                  opts.SnapshotID,
+		"source_volid":                 opts.SourceVolID,
+		"metadata":                     map[string]string{},
+		"attachments":                  []interface{}{},
+		"bootable":                     "false",
+		"encrypted":                    false,
+		"multiattach":                  opts.Multiattach,
+		"replication_status":           "disabled",
+		"user_id":                      t.user.id,
+		"os-vol-tenant-attr:tenant_id": t.project.id,
+		"links": []map[string]interface{}{
+			{"rel": "self", "href": c.URL() + "/volume/v3/" + t.project.id + "/volumes/" + id},
+		},
+	}
+	if opts.Name != nil {
+		v["name"] = *opts.Name
+	}
+	if opts.Description != nil {
+		v["description"] = *opts.Description
+	}
+	if opts.AvailabilityZone != "" {
+		v["availability_zone"] = opts.AvailabilityZone
+	}
+	if opts.VolumeType != "" {
+		v["volume_type"] = opts.VolumeType
+	}
+	if opts.ImageID != "" {
+		v["bootable"] = "true"
+	}
+	if opts.Metadata != nil {
+		v["metadata"] = opts.Metadata
+	}
+	c.volumes.add(v)
+
+	writeJSON(w, http.StatusAccepted, map[string]interface{}{"volume": v})
+}
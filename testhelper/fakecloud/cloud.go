@@ -0,0 +1,334 @@
+package fakecloud
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+const (
+	// Region is the region of every endpoint in the catalog.
+	Region = "RegionOne"
+
+	// DomainID and DomainName identify the only domain of the cloud.
+	DomainID   = "default"
+	DomainName = "Default"
+
+	// Username, Password and ProjectName are the credentials of the admin
+	// user, which is created along with the cloud.
+	Username    = "admin"
+	Password    = "secret"
+	ProjectName = "admin"
+)
+
+// Cloud is a fake OpenStack cloud served over HTTP. Its zero value isn't
+// usable, create one with New.
+type Cloud struct {
+	// Server is the HTTP server of the cloud.
+	Server *httptest.Server
+
+	// TokenTTL is the validity of the tokens issued from now on. Defaults to
+	// one hour.
+	TokenTTL time.Duration
+
+	mu       sync.Mutex
+	users    map[string]*user
+	projects map[string]*project
+	tokens   map[string]*token
+	servers  *collection
+	networks *collection
+	ports    *collection
+	volumes  *collection
+	accounts map[string]*account
+	lastIP   int
+
+	// serverPorts holds the IDs of the ports created along with each server,
+	// which are deleted along with it.
+	serverPorts map[string][]string
+
+	// accessTokens holds the tokens issued by the OpenID Connect provider.
+	accessTokens map[string]*accessToken
+}
+
+type user struct {
+	id        string
+	name      string
+	password  string
+	projectID string
+	roles     []string
+}
+
+type project struct {
+	id   string
+	name string
+}
+
+type token struct {
+	id        string
+	user      *user
+	project   *project
+	methods   []string
+	issuedAt  time.Time
+	expiresAt time.Time
+}
+
+// New starts a fake cloud with an admin user. Call Close to stop it.
+func New() *Cloud {
+	c := &Cloud{
+		TokenTTL: time.Hour,
+		users:    make(map[string]*user),
+		projects: make(map[string]*project),
+		tokens:   make(map[string]*token),
+		servers:  newCollection(),
+		networks: newCollection(),
+		ports:    newCollection(),
+		volumes:  newCollection(),
+		accounts: make(map[string]*account),
+
+		serverPorts:  make(map[string][]string),
+		accessTokens: make(map[string]*accessToken),
+	}
+	c.AddUser(Username, Password, ProjectName, "admin", "member", "reader")
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/identity/", c.handleIdentity)
+	mux.HandleFunc("/compute/v2.1/", c.handleCompute)
+	mux.HandleFunc("/network/v2.0/", c.handleNetwork)
+	mux.HandleFunc("/volume/v3/", c.handleVolume)
+	mux.HandleFunc("/object-store/v1/", c.handleObjectStorage)
+	mux.HandleFunc("/oidc/", c.handleOIDC)
+
+	c.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		mux.ServeHTTP(w, r)
+	}))
+
+	return c
+}
+
+// Close stops the cloud.
+func (c *Cloud) Close() {
+	c.Server.Close()
+}
+
+// URL returns the base URL of the cloud.
+func (c *Cloud) URL() string {
+	return c.Server.URL
+}
+
+// IdentityEndpoint returns the Keystone v3 endpoint of the cloud.
+func (c *Cloud) IdentityEndpoint() string {
+	return c.URL() + "/identity/v3/"
+}
+
+// AuthOptions returns options to authenticate as the admin user, scoped to
+// the admin project. Reauthentication is allowed.
+func (c *Cloud) AuthOptions() gophercloud.AuthOptions {
+	return gophercloud.AuthOptions{
+		IdentityEndpoint: c.IdentityEndpoint(),
+		Username:         Username,
+		Password:         Password,
+		DomainID:         DomainID,
+		TenantName:       ProjectName,
+		AllowReauth:      true,
+	}
+}
+
+// AddUser creates a user with the given roles in projectName, which is
+// created if needed. It returns the ID of the user.
+func (c *Cloud) AddUser(name, password, projectName string, roles ...string) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	p, ok := c.projects[projectName]
+	if !ok {
+		p = &project{id: newHexID(), name: projectName}
+		c.projects[projectName] = p
+	}
+
+	u := &user{
+		id:        newHexID(),
+		name:      name,
+		password:  password,
+		projectID: p.id,
+		roles:     roles,
+	}
+	c.users[name] = u
+	return u.id
+}
+
+// RevokeTokens revokes every token issued so far, as if they had expired.
+func (c *Cloud) RevokeTokens() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.tokens = make(map[string]*token)
+}
+
+// authenticate returns the token of an API request. It writes a 401
+// response and returns nil if the token is missing or invalid, or if it isn't
+// scoped to a project.
+func (c *Cloud) authenticate(w http.ResponseWriter, r *http.Request) *token {
+	t := c.validToken(r.Header.Get("X-Auth-Token"))
+	if t == nil || t.project == nil {
+		writeUnauthorized(w)
+		return nil
+	}
+	return t
+}
+
+// writeUnauthorized writes a 401 response in the format of Keystone.
+func writeUnauthorized(w http.ResponseWriter) {
+	writeJSON(w, http.StatusUnauthorized, map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":    http.StatusUnauthorized,
+			"message": "The request you have made requires authentication.",
+			"title":   "Unauthorized",
+		},
+	})
+}
+
+func (c *Cloud) validToken(id string) *token {
+	t, ok := c.tokens[id]
+	if !ok {
+		return nil
+	}
+	if time.Now().After(t.expiresAt) {
+		delete(c.tokens, id)
+		return nil
+	}
+	return t
+}
+
+// collection stores the resources of one type, in creation order.
+type collection struct {
+	ids   []string
+	items map[string]map[string]interface{}
+}
+
+func newCollection() *collection {
+	return &collection{items: make(map[string]map[string]interface{})}
+}
+
+func (c *collection) add(item map[string]interface{}) {
+	id := item["id"].(string)
+	c.ids = append(c.ids, id)
+	c.items[id] = item
+}
+
+func (c *collection) get(id string) (map[string]interface{}, bool) {
+	item, ok := c.items[id]
+	return item, ok
+}
+
+func (c *collection) remove(id string) {
+	delete(c.items, id)
+	for i, v := range c.ids {
+		if v == id {
+			c.ids = append(c.ids[:i], c.ids[i+1:]...)
+			return
+		}
+	}
+}
+
+// list returns the items for which keep returns true.
+func (c *collection) list(keep func(map[string]interface{}) bool) []map[string]interface{} {
+	items := make([]map[string]interface{}, 0, len(c.ids))
+	for _, id := range c.ids {
+		if item := c.items[id]; keep(item) {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// paginate returns the body of a list response under key. It honours the
+// limit and marker query parameters, and adds a next link under key_links
+// if more items are available.
+func (c *Cloud) paginate(r *http.Request, key string, items []map[string]interface{}) map[string]interface{} {
+	q := r.URL.Query()
+
+	if marker := q.Get("marker"); marker != "" {
+		rest := items[:0:0]
+		for i, item := range items {
+			if item["id"] == marker {
+				rest = items[i+1:]
+				break
+			}
+		}
+		items = rest
+	}
+
+	body := make(map[string]interface{})
+	if limit, err := strconv.Atoi(q.Get("limit")); err == nil && limit > 0 && len(items) > limit {
+		items = items[:limit]
+		q.Set("marker", items[limit-1]["id"].(string))
+		next := c.URL() + r.URL.Path + "?" + q.Encode()
+		body[key+"_links"] = []map[string]interface{}{{"rel": "next", "href": next}}
+	}
+	body[key] = items
+
+	return body
+}
+
+// matchQuery reports whether the fields of item equal the values of the
+// corresponding query parameters, if set.
+func matchQuery(item map[string]interface{}, q url.Values, fields ...string) bool {
+	for _, f := range fields {
+		if v := q.Get(f); v != "" && fmt.Sprint(item[f]) != v {
+			return false
+		}
+	}
+	return true
+}
+
+// splitPath returns the segments of the path following prefix.
+func splitPath(path, prefix string) []string {
+	path = strings.Trim(strings.TrimPrefix(path, prefix), "/")
+	if path == "" {
+		return nil
+	}
+	return strings.Split(path, "/")
+}
+
+func readJSON(r *http.Request, v interface{}) error {
+	return json.NewDecoder(r.Body).Decode(v)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeFault writes an error in the format of Nova and Cinder.
+func writeFault(w http.ResponseWriter, status int, name, message string) {
+	writeJSON(w, status, map[string]interface{}{
+		name: map[string]interface{}{
+			"code":    status,
+			"message": message,
+		},
+	})
+}
+
+func newHexID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+func newUUID() string {
+	id := newHexID()
+	return id[:8] + "-" + id[8:12] + "-" + id[12:16] + "-" + id[16:20] + "-" + id[20:]
+}
@@ -0,0 +1,255 @@
+package fakecloud
+
+import (
+	"net/http"
+	"time"
+)
+
+// flavors are the flavors of the cloud, which can't be changed.
+var flavors = []map[string]interface{}{
+	{"id": "1", "name": "m1.tiny", "ram": 512, "vcpus": 1, "disk": 1, "swap": "", "rxtx_factor": 1.0, "os-flavor-access:is_public": true},
+	{"id": "2", "name": "m1.small", "ram": 2048, "vcpus": 1, "disk": 20, "swap": "", "rxtx_factor": 1.0, "os-flavor-access:is_public": true},
+	{"id": "3", "name": "m1.medium", "ram": 4096, "vcpus": 2, "disk": 40, "swap": "", "rxtx_factor": 1.0, "os-flavor-access:is_public": true},
+}
+
+type serverRequest struct {
+	Server struct {
+		Name           string              `json:"name"`
+		ImageRef       string              `json:"imageRef"`
+		FlavorRef      string              `json:"flavorRef"`
+		KeyName        string              `json:"key_name"`
+		Metadata       map[string]string   `json:"metadata"`
+		SecurityGroups []map[string]string `json:"security_groups"`
+		Networks       []map[string]string `json:"networks"`
+		AccessIPv4     string              `json:"accessIPv4"`
+		AccessIPv6     string              `json:"accessIPv6"`
+	} `json:"server"`
+}
+
+func (c *Cloud) handleCompute(w http.ResponseWriter, r *http.Request) {
+	t := c.authenticate(w, r)
+	if t == nil {
+		return
+	}
+
+	path := splitPath(r.URL.Path, "/compute/v2.1")
+	switch {
+	case len(path) == 0:
+		http.NotFound(w, r)
+	case path[0] == "servers":
+		c.handleServers(w, r, t, path[1:])
+	case path[0] == "flavors":
+		c.handleFlavors(w, r, path[1:])
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (c *Cloud) handleServers(w http.ResponseWriter, r *http.Request, t *token, path []string) {
+	if len(path) == 0 || (len(path) == 1 && path[0] == "detail") {
+		switch r.Method {
+		case "GET":
+			q := r.URL.Query()
+			servers := c.servers.list(func(s map[string]interface{}) bool {
+				return s["tenant_id"] == t.project.id && matchQuery(s, q, "name", "status")
+			})
+			writeJSON(w, http.StatusOK, c.paginate(r, "servers", servers))
+		case "POST":
+			if len(path) != 0 {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+				return
+			}
+			c.createServer(w, r, t)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+		return
+	}
+
+	s, ok := c.servers.get(path[0])
+	if !ok || s["tenant_id"] != t.project.id || len(path) > 1 {
+		writeFault(w, http.StatusNotFound, "itemNotFound", "Instance "+path[0]+" could not be found.")
+		return
+	}
+
+	switch r.Method {
+	case "GET":
+		writeJSON(w, http.StatusOK, map[string]interface{}{"server": s})
+	case "PUT":
+		var req serverRequest
+		if err := readJSON(r, &req); err != nil {
+			writeFault(w, http.StatusBadRequest, "badRequest", err.Error())
+			return
+		}
+		if req.Server.Name != "" {
+			s["name"] = req.Server.Name
+		}
+		if req.Server.AccessIPv4 != "" {
+			s["accessIPv4"] = req.Server.AccessIPv4
+		}
+		if req.Server.AccessIPv6 != "" {
+			s["accessIPv6"] = req.Server.AccessIPv6
+		}
+		s["updated"] = time.Now().UTC().Format(time.RFC3339)
+		writeJSON(w, http.StatusOK, map[string]interface{}{"server": s})
+	case "DELETE":
+		id := s["id"].(string)
+		for _, portID := range c.serverPorts[id] {
+			c.ports.remove(portID)
+		}
+		delete(c.serverPorts, id)
+		for _, p := range c.ports.list(func(p map[string]interface{}) bool { return p["device_id"] == id }) {
+			p["device_id"] = ""
+			p["device_owner"] = ""
+			p["status"] = "DOWN"
+		}
+		c.servers.remove(id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (c *Cloud) createServer(w http.ResponseWriter, r *http.Request, t *token) {
+	var req serverRequest
+	if err := readJSON(r, &req); err != nil {
+		writeFault(w, http.StatusBadRequest, "badRequest", err.Error())
+		return
+	}
+	opts := req.Server
+	if opts.Name == "" || opts.FlavorRef == "" {
+		writeFault(w, http.StatusBadRequest, "badRequest", "Invalid input for field/attribute server: 'name' and 'flavorRef' are required.")
+		return
+	}
+	if _, ok := findFlavor(opts.FlavorRef); !ok {
+		writeFault(w, http.StatusBadRequest, "badRequest", "Flavor "+opts.FlavorRef+" could not be found.")
+		return
+	}
+
+	// Resolve every network before creating any port, so that a failed
+	// request leaves the cloud unchanged.
+	type attachment struct {
+		network map[string]interface{}
+		port    map[string]interface{}
+	}
+	var attachments []attachment
+	for _, n := range opts.Networks {
+		var a attachment
+		networkID := n["uuid"]
+		if n["port"] != "" {
+			p, ok := c.ports.get(n["port"])
+			if !ok || p["project_id"] != t.project.id {
+				writeFault(w, http.StatusBadRequest, "badRequest", "Port "+n["port"]+" could not be found.")
+				return
+			}
+			if p["device_id"] != "" {
+				writeFault(w, http.StatusConflict, "conflictingRequest", "Port "+n["port"]+" is still in use.")
+				return
+			}
+			a.port = p
+			networkID = p["network_id"].(string)
+		}
+
+		network, ok := c.networks.get(networkID)
+		if !ok {
+			writeFault(w, http.StatusBadRequest, "badRequest", "Network "+networkID+" could not be found.")
+			return
+		}
+		a.network = network
+		attachments = append(attachments, a)
+	}
+
+	id := newUUID()
+	addresses := make(map[string]interface{})
+	for _, a := range attachments {
+		port := a.port
+		if port == nil {
+			port = c.newPort(t, a.network["id"].(string))
+			c.ports.add(port)
+			c.serverPorts[id] = append(c.serverPorts[id], port["id"].(string))
+		}
+		port["device_id"] = id
+		port["device_owner"] = "compute:nova"
+		port["status"] = "ACTIVE"
+
+		ip := port["fixed_ips"].([]map[string]interface{})[0]["ip_address"]
+		addresses[a.network["name"].(string)] = []map[string]interface{}{{
+			"version":                 4,
+			"addr":                    ip,
+			"OS-EXT-IPS:type":         "fixed",
+			"OS-EXT-IPS-MAC:mac_addr": port["mac_address"],
+		}}
+	}
+
+	securityGroups := opts.SecurityGroups
+	if len(securityGroups) == 0 {
+		securityGroups = []map[string]string{{"name": "default"}}
+	}
+	metadata := opts.Metadata
+	if metadata == nil {
+		metadata = make(map[string]string)
+	}
+	links := []map[string]interface{}{
+		{"rel": "self", "href": c.URL() + "/compute/v2.1/servers/" + id},
+		{"rel": "bookmark", "href": c.URL() + "/compute/servers/" + id},
+	}
+	now := time.Now().UTC().Format(time.RFC3339)
+
+	c.servers.add(map[string]interface{}{
+		"id":              id,
+		"name":            opts.Name,
+		"tenant_id":       t.project.id,
+		"user_id":         t.user.id,
+		"status":          "ACTIVE",
+		"progress":        100,
+		"created":         now,
+		"updated":         now,
+		"hostId":          newHexID(),
+		"image":           map[string]interface{}{"id": opts.ImageRef},
+		"flavor":          map[string]interface{}{"id": opts.FlavorRef},
+		"addresses":       addresses,
+		"metadata":        metadata,
+		"key_name":        opts.KeyName,
+		"accessIPv4":      opts.AccessIPv4,
+		"accessIPv6":      opts.AccessIPv6,
+		"links":           links,
+		"security_groups": securityGroups,
+	})
+
+	writeJSON(w, http.StatusAccepted, map[string]interface{}{
+		"server": map[string]interface{}{
+			"id":              id,
+			"links":           links,
+			"adminPass":       newHexID()[:12],
+			"security_groups": securityGroups,
+		},
+	})
+}
+
+func (c *Cloud) handleFlavors(w http.ResponseWriter, r *http.Request, path []string) {
+	if r.Method != "GET" {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	if len(path) == 0 || (len(path) == 1 && path[0] == "detail") {
+		writeJSON(w, http.StatusOK, c.paginate(r, "flavors", flavors))
+		return
+	}
+
+	f, ok := findFlavor(path[0])
+	if !ok || len(path) > 1 {
+		writeFault(w, http.StatusNotFound, "itemNotFound", "Flavor "+path[0]+" could not be found.")
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"flavor": f})
+}
+
+func findFlavor(id string) (map[string]interface{}, bool) {
+	for _, f := range flavors {
+		if f["id"] == id {
+			return f, true
+		}
+	}
+	return nil, false
+}
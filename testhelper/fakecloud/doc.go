@@ -0,0 +1,45 @@
+/*
+Package fakecloud provides an in-process, stateful fake OpenStack cloud for
+end-to-end tests of code built on gophercloud.
+
+The fake cloud issues Keystone v3 tokens along with a service catalog, and
+keeps in memory the resources created through the Compute (Nova servers and
+flavors), Networking (Neutron networks and ports), Block Storage (Cinder
+volumes) and Object Storage (Swift containers and objects) APIs. Only the
+most common calls and fields of those APIs are implemented. An OpenID Connect
+provider federated with the identity service is served as well, for
+authentication with the oidc package.
+
+Example to Use the Fake Cloud
+
+	cloud := fakecloud.New()
+	defer cloud.Close()
+
+	provider, err := openstack.AuthenticatedClient(cloud.AuthOptions())
+	if err != nil {
+		panic(err)
+	}
+
+	computeClient, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{
+		Region: fakecloud.Region,
+	})
+
+	server, err := servers.Create(computeClient, servers.CreateOpts{
+		Name:      "test",
+		FlavorRef: "1",
+	}).Extract()
+
+Example to Test Reauthentication
+
+	cloud.TokenTTL = time.Minute
+	provider, err := openstack.AuthenticatedClient(cloud.AuthOptions())
+
+	// Every token is rejected from now on, as if it had expired.
+	cloud.RevokeTokens()
+
+Example to Authenticate through OpenID Connect
+
+	provider, err := openstack.NewClient(cloud.IdentityEndpoint())
+	err = openstack.AuthenticateV3(provider, cloud.OIDCAuthOptions(), gophercloud.EndpointOpts{})
+*/
+package fakecloud
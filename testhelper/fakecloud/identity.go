@@ -0,0 +1,274 @@
+package fakecloud
+
+import (
+	"net/http"
+	"strings"
+	"time"
+)
+
+// keystoneTimeFormat is the format of the timestamps in Keystone responses.
+const keystoneTimeFormat = "2006-01-02T15:04:05.000000Z"
+
+var roleIDs = map[string]string{
+	"admin":  "e1aa0c9c8b7c4c8e9b0c3a3f0d6c3b21",
+	"member": "9fe2ff9ee4384b1894a90878d3e92bab",
+	"reader": "6a2d8a1e5f1b4e2c8e6d7f8a9b0c1d2e",
+}
+
+func (c *Cloud) handleIdentity(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/identity/", "/identity":
+		writeJSON(w, http.StatusMultipleChoices, map[string]interface{}{
+			"versions": map[string]interface{}{
+				"values": []interface{}{c.identityVersion()},
+			},
+		})
+	case "/identity/v3/", "/identity/v3":
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"version": c.identityVersion(),
+		})
+	case "/identity/v3/auth/tokens":
+		c.handleTokens(w, r)
+	default:
+		if strings.HasPrefix(r.URL.Path, "/identity/v3/OS-FEDERATION/identity_providers/") {
+			c.handleFederationAuth(w, r)
+			return
+		}
+		http.NotFound(w, r)
+	}
+}
+
+func (c *Cloud) identityVersion() map[string]interface{} {
+	return map[string]interface{}{
+		"id":      "v3.14",
+		"status":  "stable",
+		"updated": "2020-04-07T00:00:00Z",
+		"links": []interface{}{
+			map[string]interface{}{"rel": "self", "href": c.IdentityEndpoint()},
+		},
+	}
+}
+
+func (c *Cloud) handleTokens(w http.ResponseWriter, r *http.Request) {
+	if r.Method == "POST" {
+		c.createToken(w, r)
+		return
+	}
+
+	// Unscoped tokens can validate tokens too.
+	if c.validToken(r.Header.Get("X-Auth-Token")) == nil {
+		writeUnauthorized(w)
+		return
+	}
+	subject := c.validToken(r.Header.Get("X-Subject-Token"))
+
+	switch r.Method {
+	case "GET":
+		if subject == nil {
+			writeJSON(w, http.StatusNotFound, map[string]interface{}{
+				"error": map[string]interface{}{
+					"code":    http.StatusNotFound,
+					"message": "Could not find token.",
+					"title":   "Not Found",
+				},
+			})
+			return
+		}
+		w.Header().Set("X-Subject-Token", subject.id)
+		writeJSON(w, http.StatusOK, c.tokenBody(subject))
+	case "HEAD":
+		if subject == nil {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	case "DELETE":
+		if subject == nil {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		delete(c.tokens, subject.id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+type domainRef struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type authRequest struct {
+	Auth struct {
+		Identity struct {
+			Methods  []string `json:"methods"`
+			Password *struct {
+				User struct {
+					ID       string     `json:"id"`
+					Name     string     `json:"name"`
+					Password string     `json:"password"`
+					Domain   *domainRef `json:"domain"`
+				} `json:"user"`
+			} `json:"password"`
+			Token *struct {
+				ID string `json:"id"`
+			} `json:"token"`
+		} `json:"identity"`
+		Scope *struct {
+			Project *struct {
+				ID     string     `json:"id"`
+				Name   string     `json:"name"`
+				Domain *domainRef `json:"domain"`
+			} `json:"project"`
+		} `json:"scope"`
+	} `json:"auth"`
+}
+
+func (c *Cloud) createToken(w http.ResponseWriter, r *http.Request) {
+	var req authRequest
+	if err := readJSON(r, &req); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]interface{}{
+			"error": map[string]interface{}{
+				"code":    http.StatusBadRequest,
+				"message": "Malformed request body: " + err.Error(),
+				"title":   "Bad Request",
+			},
+		})
+		return
+	}
+
+	identity := req.Auth.Identity
+	var u *user
+	var p *project
+	switch {
+	case identity.Password != nil:
+		pu := identity.Password.User
+		for _, candidate := range c.users {
+			if candidate.id == pu.ID || (pu.ID == "" && candidate.name == pu.Name && validDomain(pu.Domain)) {
+				u = candidate
+			}
+		}
+		if u != nil && u.password != pu.Password {
+			u = nil
+		}
+	case identity.Token != nil:
+		if t := c.validToken(identity.Token.ID); t != nil {
+			u, p = t.user, t.project
+		}
+	}
+	if u == nil {
+		writeUnauthorized(w)
+		return
+	}
+
+	if req.Auth.Scope != nil && req.Auth.Scope.Project != nil {
+		sp := req.Auth.Scope.Project
+		p = nil
+		for _, candidate := range c.projects {
+			if candidate.id == sp.ID || (sp.ID == "" && candidate.name == sp.Name && validDomain(sp.Domain)) {
+				p = candidate
+			}
+		}
+		if p == nil || p.id != u.projectID {
+			writeUnauthorized(w)
+			return
+		}
+	}
+	if p == nil {
+		for _, candidate := range c.projects {
+			if candidate.id == u.projectID {
+				p = candidate
+			}
+		}
+	}
+
+	now := time.Now().UTC()
+	t := &token{
+		id:        newHexID(),
+		user:      u,
+		project:   p,
+		methods:   identity.Methods,
+		issuedAt:  now,
+		expiresAt: now.Add(c.TokenTTL),
+	}
+	c.tokens[t.id] = t
+
+	w.Header().Set("X-Subject-Token", t.id)
+	writeJSON(w, http.StatusCreated, c.tokenBody(t))
+}
+
+// validDomain reports whether d designates the domain of the cloud. A
+// missing domain is invalid.
+func validDomain(d *domainRef) bool {
+	return d != nil && (d.ID == DomainID || d.Name == DomainName)
+}
+
+func (c *Cloud) tokenBody(t *token) map[string]interface{} {
+	domain := map[string]interface{}{"id": DomainID, "name": DomainName}
+
+	roles := make([]interface{}, 0, len(t.user.roles))
+	for _, name := range t.user.roles {
+		id, ok := roleIDs[name]
+		if !ok {
+			id = name
+		}
+		roles = append(roles, map[string]interface{}{"id": id, "name": name})
+	}
+
+	body := map[string]interface{}{
+		"methods":    t.methods,
+		"issued_at":  t.issuedAt.Format(keystoneTimeFormat),
+		"expires_at": t.expiresAt.Format(keystoneTimeFormat),
+		"audit_ids":  []string{t.id[:22]},
+		"user": map[string]interface{}{
+			"id":     t.user.id,
+			"name":   t.user.name,
+			"domain": domain,
+		},
+	}
+
+	// Unscoped tokens, such as those obtained through federation, have
+	// neither roles nor catalog.
+	if t.project != nil {
+		body["project"] = map[string]interface{}{
+			"id":     t.project.id,
+			"name":   t.project.name,
+			"domain": domain,
+		}
+		body["roles"] = roles
+		body["catalog"] = c.catalog(t.project.id)
+	}
+
+	return map[string]interface{}{"token": body}
+}
+
+func (c *Cloud) catalog(projectID string) []interface{} {
+	entry := func(serviceType, name, url string) interface{} {
+		var endpoints []interface{}
+		for _, iface := range []string{"public", "internal", "admin"} {
+			endpoints = append(endpoints, map[string]interface{}{
+				"id":        newHexID(),
+				"interface": iface,
+				"region":    Region,
+				"region_id": Region,
+				"url":       url,
+			})
+		}
+		return map[string]interface{}{
+			"id":        newHexID(),
+			"type":      serviceType,
+			"name":      name,
+			"endpoints": endpoints,
+		}
+	}
+
+	return []interface{}{
+		entry("identity", "keystone", c.URL()+"/identity"),
+		entry("compute", "nova", c.URL()+"/compute/v2.1"),
+		entry("network", "neutron", c.URL()+"/network"),
+		entry("volumev3", "cinderv3", c.URL()+"/volume/v3/"+projectID),
+		entry("block-storage", "cinder", c.URL()+"/volume/v3/"+projectID),
+		entry("object-store", "swift", c.URL()+"/object-store/v1/AUTH_"+projectID),
+	}
+}
@@ -0,0 +1,262 @@
+package fakecloud
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type networkRequest struct {
+	Network struct {
+		Name         *string `json:"name"`
+		AdminStateUp *bool   `json:"admin_state_up"`
+		Shared       *bool   `json:"shared"`
+		Description  *string `json:"description"`
+	} `json:"network"`
+}
+
+type portRequest struct {
+	Port struct {
+		NetworkID    string  `json:"network_id"`
+		Name         *string `json:"name"`
+		Description  *string `json:"description"`
+		AdminStateUp *bool   `json:"admin_state_up"`
+		DeviceID     *string `json:"device_id"`
+		DeviceOwner  *string `json:"device_owner"`
+	} `json:"port"`
+}
+
+func (c *Cloud) handleNetwork(w http.ResponseWriter, r *http.Request) {
+	t := c.authenticate(w, r)
+	if t == nil {
+		return
+	}
+
+	path := splitPath(r.URL.Path, "/network/v2.0")
+	switch {
+	case len(path) == 0:
+		http.NotFound(w, r)
+	case path[0] == "networks":
+		c.handleNetworks(w, r, t, path[1:])
+	case path[0] == "ports":
+		c.handlePorts(w, r, t, path[1:])
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (c *Cloud) handleNetworks(w http.ResponseWriter, r *http.Request, t *token, path []string) {
+	if len(path) == 0 {
+		switch r.Method {
+		case "GET":
+			q := r.URL.Query()
+			networks := c.networks.list(func(n map[string]interface{}) bool {
+				return (n["project_id"] == t.project.id || n["shared"] == true) &&
+					matchQuery(n, q, "id", "name", "status", "admin_state_up", "shared", "project_id", "tenant_id")
+			})
+			writeJSON(w, http.StatusOK, c.paginate(r, "networks", networks))
+		case "POST":
+			var req networkRequest
+			if err := readJSON(r, &req); err != nil {
+				writeNeutronError(w, http.StatusBadRequest, "HTTPBadRequest", err.Error())
+				return
+			}
+			now := time.Now().UTC().Format(time.RFC3339)
+			n := map[string]interface{}{
+				"id":                        newUUID(),
+				"name":                      "",
+				"description":               "",
+				"status":                    "ACTIVE",
+				"admin_state_up":            true,
+				"shared":                    false,
+				"subnets":                   []string{},
+				"tenant_id":                 t.project.id,
+				"project_id":                t.project.id,
+				"availability_zone_hints":   []string{},
+				"created_at":                now,
+				"updated_at":                now,
+				"revision_number":           0,
+				"tags":                      []string{},
+				"port_security_enabled":     true,
+				"router:external":           false,
+				"provider:network_type":     "vxlan",
+				"provider:physical_network": nil,
+			}
+			updateNetwork(n, req)
+			c.networks.add(n)
+			writeJSON(w, http.StatusCreated, map[string]interface{}{"network": n})
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+		return
+	}
+
+	n, ok := c.networks.get(path[0])
+	if !ok || (n["project_id"] != t.project.id && n["shared"] != true) || len(path) > 1 {
+		writeNeutronError(w, http.StatusNotFound, "NetworkNotFound", "Network "+path[0]+" could not be found.")
+		return
+	}
+
+	switch r.Method {
+	case "GET":
+		writeJSON(w, http.StatusOK, map[string]interface{}{"network": n})
+	case "PUT":
+		var req networkRequest
+		if err := readJSON(r, &req); err != nil {
+			writeNeutronError(w, http.StatusBadRequest, "HTTPBadRequest", err.Error())
+			return
+		}
+		updateNetwork(n, req)
+		n["updated_at"] = time.Now().UTC().Format(time.RFC3339)
+		n["revision_number"] = n["revision_number"].(int) + 1
+		writeJSON(w, http.StatusOK, map[string]interface{}{"network": n})
+	case "DELETE":
+		inUse := c.ports.list(func(p map[string]interface{}) bool {
+			return p["network_id"] == n["id"] && p["device_owner"] != ""
+		})
+		if len(inUse) > 0 {
+			writeNeutronError(w, http.StatusConflict, "NetworkInUse",
+				fmt.Sprintf("Unable to complete operation on network %s. There are one or more ports still in use on the network.", n["id"]))
+			return
+		}
+		for _, p := range c.ports.list(func(p map[string]interface{}) bool { return p["network_id"] == n["id"] }) {
+			c.ports.remove(p["id"].(string))
+		}
+		c.networks.remove(n["id"].(string))
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func updateNetwork(n map[string]interface{}, req networkRequest) {
+	if v := req.Network.Name; v != nil {
+		n["name"] = *v
+	}
+	if v := req.Network.Description; v != nil {
+		n["description"] = *v
+	}
+	if v := req.Network.AdminStateUp; v != nil {
+		n["admin_state_up"] = *v
+	}
+	if v := req.Network.Shared; v != nil {
+		n["shared"] = *v
+	}
+}
+
+func (c *Cloud) handlePorts(w http.ResponseWriter, r *http.Request, t *token, path []string) {
+	if len(path) == 0 {
+		switch r.Method {
+		case "GET":
+			q := r.URL.Query()
+			ports := c.ports.list(func(p map[string]interface{}) bool {
+				return p["project_id"] == t.project.id &&
+					matchQuery(p, q, "id", "name", "status", "network_id", "device_id", "device_owner", "mac_address", "project_id", "tenant_id")
+			})
+			writeJSON(w, http.StatusOK, c.paginate(r, "ports", ports))
+		case "POST":
+			var req portRequest
+			if err := readJSON(r, &req); err != nil {
+				writeNeutronError(w, http.StatusBadRequest, "HTTPBadRequest", err.Error())
+				return
+			}
+			if _, ok := c.networks.get(req.Port.NetworkID); !ok {
+				writeNeutronError(w, http.StatusNotFound, "NetworkNotFound", "Network "+req.Port.NetworkID+" could not be found.")
+				return
+			}
+			p := c.newPort(t, req.Port.NetworkID)
+			updatePort(p, req)
+			c.ports.add(p)
+			writeJSON(w, http.StatusCreated, map[string]interface{}{"port": p})
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+		return
+	}
+
+	p, ok := c.ports.get(path[0])
+	if !ok || p["project_id"] != t.project.id || len(path) > 1 {
+		writeNeutronError(w, http.StatusNotFound, "PortNotFound", "Port "+path[0]+" could not be found.")
+		return
+	}
+
+	switch r.Method {
+	case "GET":
+		writeJSON(w, http.StatusOK, map[string]interface{}{"port": p})
+	case "PUT":
+		var req portRequest
+		if err := readJSON(r, &req); err != nil {
+			writeNeutronError(w, http.StatusBadRequest, "HTTPBadRequest", err.Error())
+			return
+		}
+		updatePort(p, req)
+		p["updated_at"] = time.Now().UTC().Format(time.RFC3339)
+		p["revision_number"] = p["revision_number"].(int) + 1
+		writeJSON(w, http.StatusOK, map[string]interface{}{"port": p})
+	case "DELETE":
+		c.ports.remove(p["id"].(string))
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// newPort returns a new unbound port on a network, with a fixed IP address.
+// It isn't added to the cloud.
+func (c *Cloud) newPort(t *token, networkID string) map[string]interface{} {
+	c.lastIP++
+	now := time.Now().UTC().Format(time.RFC3339)
+
+	return map[string]interface{}{
+		"id":             newUUID(),
+		"name":           "",
+		"description":    "",
+		"network_id":     networkID,
+		"status":         "DOWN",
+		"admin_state_up": true,
+		"mac_address":    fmt.Sprintf("fa:16:3e:%02x:%02x:%02x", c.lastIP>>16&0xff, c.lastIP>>8&0xff, c.lastIP&0xff),
+		"fixed_ips": []map[string]interface{}{{
+			"subnet_id":  "",
+			"ip_address": fmt.Sprintf("10.0.%d.%d", c.lastIP/254, c.lastIP%254+1),
+		}},
+		"device_id":             "",
+		"device_owner":          "",
+		"security_groups":       []string{},
+		"allowed_address_pairs": []interface{}{},
+		"tenant_id":             t.project.id,
+		"project_id":            t.project.id,
+		"created_at":            now,
+		"updated_at":            now,
+		"revision_number":       0,
+		"tags":                  []string{},
+	}
+}
+
+func updatePort(p map[string]interface{}, req portRequest) {
+	if v := req.Port.Name; v != nil {
+		p["name"] = *v
+	}
+	if v := req.Port.Description; v != nil {
+		p["description"] = *v
+	}
+	if v := req.Port.AdminStateUp; v != nil {
+		p["admin_state_up"] = *v
+	}
+	if v := req.Port.DeviceID; v != nil {
+		p["device_id"] = *v
+	}
+	if v := req.Port.DeviceOwner; v != nil {
+		p["device_owner"] = *v
+	}
+}
+
+// writeNeutronError writes an error in the format of Neutron.
+func writeNeutronError(w http.ResponseWriter, status int, kind, message string) {
+	writeJSON(w, status, map[string]interface{}{
+		"NeutronError": map[string]interface{}{
+			"type":    kind,
+			"message": message,
+			"detail":  "",
+		},
+	})
+}
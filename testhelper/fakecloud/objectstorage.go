@@ -0,0 +1,334 @@
+package fakecloud
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// swiftTimeFormat is the format of the timestamps in Swift listings.
+const swiftTimeFormat = "2006-01-02T15:04:05.000000"
+
+type account struct {
+	metadata   http.Header
+	containers map[string]*container
+}
+
+type container struct {
+	metadata http.Header
+	created  time.Time
+	objects  map[string]*object
+}
+
+type object struct {
+	data         []byte
+	contentType  string
+	etag         string
+	lastModified time.Time
+	metadata     http.Header
+}
+
+func (c *container) bytesUsed() int {
+	var n int
+	for _, o := range c.objects {
+		n += len(o.data)
+	}
+	return n
+}
+
+func (c *Cloud) handleObjectStorage(w http.ResponseWriter, r *http.Request) {
+	t := c.authenticate(w, r)
+	if t == nil {
+		return
+	}
+
+	// Object names may contain slashes, so only the account and container
+	// are split off the path.
+	path := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/object-store/v1/"), "/", 3)
+	if path[0] != "AUTH_"+t.project.id {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	a, ok := c.accounts[t.project.id]
+	if !ok {
+		a = &account{metadata: http.Header{}, containers: make(map[string]*container)}
+		c.accounts[t.project.id] = a
+	}
+
+	switch {
+	case len(path) == 1 || path[1] == "":
+		c.handleAccount(w, r, a)
+	case len(path) == 2 || path[2] == "":
+		c.handleContainer(w, r, a, path[1])
+	default:
+		cont, ok := a.containers[path[1]]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		c.handleObject(w, r, cont, path[2])
+	}
+}
+
+func (c *Cloud) handleAccount(w http.ResponseWriter, r *http.Request, a *account) {
+	var objects, bytes int
+	for _, cont := range a.containers {
+		objects += len(cont.objects)
+		bytes += cont.bytesUsed()
+	}
+	copyHeader(w.Header(), a.metadata)
+	w.Header().Set("X-Account-Container-Count", strconv.Itoa(len(a.containers)))
+	w.Header().Set("X-Account-Object-Count", strconv.Itoa(objects))
+	w.Header().Set("X-Account-Bytes-Used", strconv.Itoa(bytes))
+
+	switch r.Method {
+	case "HEAD":
+		w.WriteHeader(http.StatusNoContent)
+	case "POST":
+		updateMetadata(a.metadata, r.Header, "X-Account-Meta-", "X-Remove-Account-Meta-")
+		w.WriteHeader(http.StatusNoContent)
+	case "GET":
+		names := make([]string, 0, len(a.containers))
+		for name := range a.containers {
+			names = append(names, name)
+		}
+		names = listNames(r, names)
+
+		if !wantsJSON(r) {
+			writeNames(w, names)
+			return
+		}
+		listing := make([]map[string]interface{}, 0, len(names))
+		for _, name := range names {
+			cont := a.containers[name]
+			listing = append(listing, map[string]interface{}{
+				"name":          name,
+				"count":         len(cont.objects),
+				"bytes":         cont.bytesUsed(),
+				"last_modified": cont.created.Format(swiftTimeFormat),
+			})
+		}
+		writeJSON(w, http.StatusOK, listing)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (c *Cloud) handleContainer(w http.ResponseWriter, r *http.Request, a *account, name string) {
+	cont, ok := a.containers[name]
+
+	if r.Method == "PUT" {
+		status := http.StatusAccepted
+		if !ok {
+			cont = &container{
+				metadata: http.Header{},
+				created:  time.Now().UTC(),
+				objects:  make(map[string]*object),
+			}
+			a.containers[name] = cont
+			status = http.StatusCreated
+		}
+		updateMetadata(cont.metadata, r.Header, "X-Container-Meta-", "X-Remove-Container-Meta-")
+		w.WriteHeader(status)
+		return
+	}
+
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	copyHeader(w.Header(), cont.metadata)
+	w.Header().Set("X-Container-Object-Count", strconv.Itoa(len(cont.objects)))
+	w.Header().Set("X-Container-Bytes-Used", strconv.Itoa(cont.bytesUsed()))
+	w.Header().Set("X-Timestamp", timestamp(cont.created))
+
+	switch r.Method {
+	case "HEAD":
+		w.WriteHeader(http.StatusNoContent)
+	case "POST":
+		updateMetadata(cont.metadata, r.Header, "X-Container-Meta-", "X-Remove-Container-Meta-")
+		w.WriteHeader(http.StatusNoContent)
+	case "DELETE":
+		if len(cont.objects) > 0 {
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprint(w, "There was a conflict when trying to complete your request.")
+			return
+		}
+		delete(a.containers, name)
+		w.WriteHeader(http.StatusNoContent)
+	case "GET":
+		names := make([]string, 0, len(cont.objects))
+		for name := range cont.objects {
+			names = append(names, name)
+		}
+		names = listNames(r, names)
+
+		if !wantsJSON(r) {
+			writeNames(w, names)
+			return
+		}
+		listing := make([]map[string]interface{}, 0, len(names))
+		for _, name := range names {
+			o := cont.objects[name]
+			listing = append(listing, map[string]interface{}{
+				"name":          name,
+				"bytes":         len(o.data),
+				"hash":          o.etag,
+				"content_type":  o.contentType,
+				"last_modified": o.lastModified.Format(swiftTimeFormat),
+			})
+		}
+		writeJSON(w, http.StatusOK, listing)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (c *Cloud) handleObject(w http.ResponseWriter, r *http.Request, cont *container, name string) {
+	o, ok := cont.objects[name]
+
+	if r.Method == "PUT" {
+		data, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		sum := md5.Sum(data)
+		etag := hex.EncodeToString(sum[:])
+		if want := r.Header.Get("ETag"); want != "" && want != etag {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			return
+		}
+
+		contentType := r.Header.Get("Content-Type")
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+		o = &object{
+			data:         data,
+			contentType:  contentType,
+			etag:         etag,
+			lastModified: time.Now().UTC(),
+			metadata:     http.Header{},
+		}
+		updateMetadata(o.metadata, r.Header, "X-Object-Meta-", "X-Remove-Object-Meta-")
+		cont.objects[name] = o
+
+		w.Header().Set("ETag", etag)
+		w.Header().Set("Last-Modified", o.lastModified.Format(http.TimeFormat))
+		w.WriteHeader(http.StatusCreated)
+		return
+	}
+
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	switch r.Method {
+	case "GET", "HEAD":
+		copyHeader(w.Header(), o.metadata)
+		w.Header().Set("Content-Type", o.contentType)
+		w.Header().Set("Content-Length", strconv.Itoa(len(o.data)))
+		w.Header().Set("ETag", o.etag)
+		w.Header().Set("Last-Modified", o.lastModified.Format(http.TimeFormat))
+		w.Header().Set("X-Timestamp", timestamp(o.lastModified))
+		w.WriteHeader(http.StatusOK)
+		if r.Method == "GET" {
+			w.Write(o.data)
+		}
+	case "POST":
+		// Unlike containers, the metadata of an object is replaced as a whole.
+		o.metadata = http.Header{}
+		updateMetadata(o.metadata, r.Header, "X-Object-Meta-", "X-Remove-Object-Meta-")
+		w.WriteHeader(http.StatusAccepted)
+	case "DELETE":
+		delete(cont.objects, name)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// listNames sorts names and applies the prefix, marker, end_marker and limit
+// query parameters of a Swift listing.
+func listNames(r *http.Request, names []string) []string {
+	q := r.URL.Query()
+	sort.Strings(names)
+
+	listed := make([]string, 0, len(names))
+	for _, name := range names {
+		if !strings.HasPrefix(name, q.Get("prefix")) {
+			continue
+		}
+		if marker := q.Get("marker"); marker != "" && name <= marker {
+			continue
+		}
+		if endMarker := q.Get("end_marker"); endMarker != "" && name >= endMarker {
+			continue
+		}
+		listed = append(listed, name)
+	}
+
+	if limit, err := strconv.Atoi(q.Get("limit")); err == nil && limit >= 0 && len(listed) > limit {
+		listed = listed[:limit]
+	}
+	return listed
+}
+
+// wantsJSON reports whether a listing is requested in JSON rather than as
+// plain text.
+func wantsJSON(r *http.Request) bool {
+	if format := r.URL.Query().Get("format"); format != "" {
+		return format == "json"
+	}
+	return strings.HasPrefix(r.Header.Get("Accept"), "application/json")
+}
+
+// writeNames writes a plain text listing. Like Swift, an empty listing is a
+// 204 response.
+func writeNames(w http.ResponseWriter, names []string) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	if len(names) == 0 {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, strings.Join(names, "\n")+"\n")
+}
+
+// updateMetadata copies to metadata the request headers starting with prefix,
+// and deletes those named by the headers starting with removePrefix.
+func updateMetadata(metadata, header http.Header, prefix, removePrefix string) {
+	for k, v := range header {
+		switch {
+		case strings.HasPrefix(k, prefix):
+			if v[0] == "" {
+				metadata.Del(k)
+			} else {
+				metadata[k] = v
+			}
+		case strings.HasPrefix(k, removePrefix):
+			metadata.Del(prefix + strings.TrimPrefix(k, removePrefix))
+		}
+	}
+}
+
+func copyHeader(dst, src http.Header) {
+	for k, v := range src {
+		dst[k] = v
+	}
+}
+
+// timestamp formats t as the value of an X-Timestamp header.
+func timestamp(t time.Time) string {
+	return fmt.Sprintf("%d.%05d", t.Unix(), t.Nanosecond()/10000)
+}
@@ -0,0 +1,170 @@
+package fakecloud
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/extensions/oidc"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/tokens"
+)
+
+const (
+	// IdentityProvider and Protocol identify the OpenID Connect provider
+	// served by the cloud in its Keystone federation configuration.
+	IdentityProvider = "fakeoidc"
+	Protocol         = "openid"
+
+	// OIDCClientID and OIDCClientSecret are the credentials of the only client
+	// of the OpenID Connect provider. The access tokens obtained through the
+	// client credentials grant belong to the admin user.
+	OIDCClientID     = "gophercloud"
+	OIDCClientSecret = "client-secret"
+)
+
+type accessToken struct {
+	user      *user
+	expiresAt time.Time
+}
+
+// OIDCDiscoveryEndpoint returns the URL of the discovery document of the
+// OpenID Connect provider of the cloud.
+func (c *Cloud) OIDCDiscoveryEndpoint() string {
+	return c.URL() + "/oidc/.well-known/openid-configuration"
+}
+
+// OIDCAuthOptions returns options to authenticate as the admin user through
+// the OpenID Connect provider, scoped to the admin project. Reauthentication
+// is allowed.
+func (c *Cloud) OIDCAuthOptions() *oidc.AuthOptions {
+	return &oidc.AuthOptions{
+		AuthType:          oidc.AuthTypePassword,
+		IdentityProvider:  IdentityProvider,
+		Protocol:          Protocol,
+		DiscoveryEndpoint: c.OIDCDiscoveryEndpoint(),
+		ClientID:          OIDCClientID,
+		ClientSecret:      OIDCClientSecret,
+		Username:          Username,
+		Password:          Password,
+		Scope: tokens.Scope{
+			ProjectName: ProjectName,
+			DomainID:    DomainID,
+		},
+		AllowReauth: true,
+	}
+}
+
+// OIDCAccessToken issues an access token of the OpenID Connect provider for
+// the given user, valid for TokenTTL. It returns an empty string if the user
+// doesn't exist.
+func (c *Cloud) OIDCAccessToken(username string) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	u, ok := c.users[username]
+	if !ok {
+		return ""
+	}
+	return c.newAccessToken(u)
+}
+
+func (c *Cloud) newAccessToken(u *user) string {
+	id := newHexID()
+	c.accessTokens[id] = &accessToken{user: u, expiresAt: time.Now().Add(c.TokenTTL)}
+	return id
+}
+
+func (c *Cloud) handleOIDC(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/oidc/.well-known/openid-configuration":
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"issuer":                c.URL() + "/oidc",
+			"token_endpoint":        c.URL() + "/oidc/token",
+			"grant_types_supported": []string{"password", "client_credentials"},
+		})
+	case "/oidc/token":
+		c.issueAccessToken(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// issueAccessToken implements the token endpoint of the OpenID Connect
+// provider, see section 5 of RFC 6749 for the responses.
+func (c *Cloud) issueAccessToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	clientID, clientSecret, ok := r.BasicAuth()
+	if !ok {
+		clientID, clientSecret = r.PostFormValue("client_id"), r.PostFormValue("client_secret")
+	}
+	if clientID != OIDCClientID || clientSecret != OIDCClientSecret {
+		writeJSON(w, http.StatusUnauthorized, map[string]interface{}{"error": "invalid_client"})
+		return
+	}
+
+	var u *user
+	switch r.PostFormValue("grant_type") {
+	case "password":
+		candidate, ok := c.users[r.PostFormValue("username")]
+		if ok && candidate.password == r.PostFormValue("password") {
+			u = candidate
+		}
+	case "client_credentials":
+		u = c.users[Username]
+	default:
+		writeJSON(w, http.StatusBadRequest, map[string]interface{}{"error": "unsupported_grant_type"})
+		return
+	}
+	if u == nil {
+		writeJSON(w, http.StatusBadRequest, map[string]interface{}{"error": "invalid_grant"})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"access_token": c.newAccessToken(u),
+		"id_token":     c.newAccessToken(u),
+		"token_type":   "Bearer",
+		"expires_in":   int(c.TokenTTL.Seconds()),
+		"scope":        r.PostFormValue("scope"),
+	})
+}
+
+// handleFederationAuth exchanges an access token of the OpenID Connect
+// provider for an unscoped Keystone token.
+func (c *Cloud) handleFederationAuth(w http.ResponseWriter, r *http.Request) {
+	parts := splitPath(r.URL.Path, "/identity/v3/OS-FEDERATION/identity_providers")
+	if len(parts) != 4 || parts[1] != "protocols" || parts[3] != "auth" ||
+		parts[0] != IdentityProvider || parts[2] != Protocol {
+		http.NotFound(w, r)
+		return
+	}
+	if r.Method != "GET" && r.Method != "POST" {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	bearer := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	at, ok := c.accessTokens[bearer]
+	if !ok || time.Now().After(at.expiresAt) {
+		delete(c.accessTokens, bearer)
+		writeUnauthorized(w)
+		return
+	}
+
+	now := time.Now().UTC()
+	t := &token{
+		id:        newHexID(),
+		user:      at.user,
+		methods:   []string{Protocol},
+		issuedAt:  now,
+		expiresAt: now.Add(c.TokenTTL),
+	}
+	c.tokens[t.id] = t
+
+	w.Header().Set("X-Subject-Token", t.id)
+	writeJSON(w, http.StatusCreated, c.tokenBody(t))
+}
@@ -0,0 +1,263 @@
+package testing
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/volumes"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/tokens"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/networks"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/containers"
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/objects"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/fakecloud"
+)
+
+var endpointOpts = gophercloud.EndpointOpts{Region: fakecloud.Region}
+
+func authenticate(t *testing.T, cloud *fakecloud.Cloud) *gophercloud.ProviderClient {
+	provider, err := openstack.AuthenticatedClient(cloud.AuthOptions())
+	th.AssertNoErr(t, err)
+	return provider
+}
+
+func TestAuthentication(t *testing.T) {
+	cloud := fakecloud.New()
+	defer cloud.Close()
+
+	provider := authenticate(t, cloud)
+	identity, err := openstack.NewIdentityV3(provider, endpointOpts)
+	th.AssertNoErr(t, err)
+
+	valid, err := tokens.Validate(identity, provider.Token())
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, valid)
+
+	project, err := tokens.Get(identity, provider.Token()).ExtractProject()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, fakecloud.ProjectName, project.Name)
+
+	_, err = openstack.AuthenticatedClient(gophercloud.AuthOptions{
+		IdentityEndpoint: cloud.IdentityEndpoint(),
+		Username:         fakecloud.Username,
+		Password:         "wrong",
+		DomainID:         fakecloud.DomainID,
+	})
+	if _, ok := err.(gophercloud.ErrDefault401); !ok {
+		t.Fatalf("Expected a 401 error, got %v", err)
+	}
+}
+
+func TestReauthentication(t *testing.T) {
+	cloud := fakecloud.New()
+	defer cloud.Close()
+
+	provider := authenticate(t, cloud)
+	token := provider.Token()
+	compute, err := openstack.NewComputeV2(provider, endpointOpts)
+	th.AssertNoErr(t, err)
+
+	cloud.RevokeTokens()
+
+	_, err = servers.List(compute, nil).AllPages()
+	th.AssertNoErr(t, err)
+	if provider.Token() == token {
+		t.Fatal("Expected a new token after reauthentication")
+	}
+}
+
+func TestOIDCAuthentication(t *testing.T) {
+	cloud := fakecloud.New()
+	defer cloud.Close()
+
+	provider, err := openstack.NewClient(cloud.IdentityEndpoint())
+	th.AssertNoErr(t, err)
+	err = openstack.AuthenticateV3(provider, cloud.OIDCAuthOptions(), gophercloud.EndpointOpts{})
+	th.AssertNoErr(t, err)
+
+	compute, err := openstack.NewComputeV2(provider, endpointOpts)
+	th.AssertNoErr(t, err)
+	_, err = servers.List(compute, nil).AllPages()
+	th.AssertNoErr(t, err)
+
+	token := provider.Token()
+	cloud.RevokeTokens()
+
+	_, err = servers.List(compute, nil).AllPages()
+	th.AssertNoErr(t, err)
+	if provider.Token() == token {
+		t.Fatal("Expected a new token after reauthentication")
+	}
+}
+
+func TestServersAndNetworks(t *testing.T) {
+	cloud := fakecloud.New()
+	defer cloud.Close()
+
+	provider := authenticate(t, cloud)
+	compute, err := openstack.NewComputeV2(provider, endpointOpts)
+	th.AssertNoErr(t, err)
+	network, err := openstack.NewNetworkV2(provider, endpointOpts)
+	th.AssertNoErr(t, err)
+
+	n, err := networks.Create(network, networks.CreateOpts{Name: "private"}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "private", n.Name)
+
+	created, err := servers.Create(compute, servers.CreateOpts{
+		Name:      "web",
+		FlavorRef: "1",
+		ImageRef:  "cirros",
+		Networks:  []servers.Network{{UUID: n.ID}},
+	}).Extract()
+	th.AssertNoErr(t, err)
+
+	server, err := servers.Get(compute, created.ID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "web", server.Name)
+	th.AssertEquals(t, "ACTIVE", server.Status)
+	if _, ok := server.Addresses["private"]; !ok {
+		t.Fatalf("Expected an address on the private network, got %v", server.Addresses)
+	}
+
+	allPorts, err := ports.List(network, ports.ListOpts{DeviceID: created.ID}).AllPages()
+	th.AssertNoErr(t, err)
+	serverPorts, err := ports.ExtractPorts(allPorts)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(serverPorts))
+	th.AssertEquals(t, n.ID, serverPorts[0].NetworkID)
+
+	err = networks.Delete(network, n.ID).ExtractErr()
+	if e, ok := err.(gophercloud.ErrUnexpectedResponseCode); !ok || e.Actual != 409 {
+		t.Fatalf("Expected a 409 error, got %v", err)
+	}
+
+	th.AssertNoErr(t, servers.Delete(compute, created.ID).ExtractErr())
+	_, err = servers.Get(compute, created.ID).Extract()
+	if _, ok := err.(gophercloud.ErrDefault404); !ok {
+		t.Fatalf("Expected a 404 error, got %v", err)
+	}
+
+	th.AssertNoErr(t, networks.Delete(network, n.ID).ExtractErr())
+}
+
+func TestServersPagination(t *testing.T) {
+	cloud := fakecloud.New()
+	defer cloud.Close()
+
+	provider := authenticate(t, cloud)
+	compute, err := openstack.NewComputeV2(provider, endpointOpts)
+	th.AssertNoErr(t, err)
+
+	for _, name := range []string{"a", "b", "c"} {
+		_, err := servers.Create(compute, servers.CreateOpts{Name: name, FlavorRef: "1"}).Extract()
+		th.AssertNoErr(t, err)
+	}
+
+	var names []string
+	pages := 0
+	err = servers.List(compute, servers.ListOpts{Limit: 2}).EachPage(func(page pagination.Page) (bool, error) {
+		pages++
+		actual, err := servers.ExtractServers(page)
+		if err != nil {
+			return false, err
+		}
+		for _, s := range actual {
+			names = append(names, s.Name)
+		}
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, pages)
+	th.CheckDeepEquals(t, []string{"a", "b", "c"}, names)
+}
+
+func TestVolumes(t *testing.T) {
+	cloud := fakecloud.New()
+	defer cloud.Close()
+
+	provider := authenticate(t, cloud)
+	blockStorage, err := openstack.NewBlockStorageV3(provider, endpointOpts)
+	th.AssertNoErr(t, err)
+
+	created, err := volumes.Create(blockStorage, volumes.CreateOpts{Name: "data", Size: 10}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "available", created.Status)
+
+	_, err = volumes.Update(blockStorage, created.ID, volumes.UpdateOpts{Name: "logs"}).Extract()
+	th.AssertNoErr(t, err)
+
+	allPages, err := volumes.List(blockStorage, nil).AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := volumes.ExtractVolumes(allPages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(actual))
+	th.AssertEquals(t, "logs", actual[0].Name)
+	th.AssertEquals(t, 10, actual[0].Size)
+
+	th.AssertNoErr(t, volumes.Delete(blockStorage, created.ID).ExtractErr())
+	_, err = volumes.Get(blockStorage, created.ID).Extract()
+	if _, ok := err.(gophercloud.ErrDefault404); !ok {
+		t.Fatalf("Expected a 404 error, got %v", err)
+	}
+}
+
+func TestObjects(t *testing.T) {
+	cloud := fakecloud.New()
+	defer cloud.Close()
+
+	provider := authenticate(t, cloud)
+	objectStorage, err := openstack.NewObjectStorageV1(provider, endpointOpts)
+	th.AssertNoErr(t, err)
+
+	_, err = containers.Create(objectStorage, "photos", containers.CreateOpts{
+		Metadata: map[string]string{"Owner": "alice"},
+	}).Extract()
+	th.AssertNoErr(t, err)
+
+	metadata, err := containers.Get(objectStorage, "photos").ExtractMetadata()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "alice", metadata["Owner"])
+
+	content := []byte("hello world")
+	_, err = objects.Create(objectStorage, "photos", "2020/cat.jpg", objects.CreateOpts{
+		Content:     bytes.NewReader(content),
+		ContentType: "image/jpeg",
+	}).Extract()
+	th.AssertNoErr(t, err)
+
+	downloaded := objects.Download(objectStorage, "photos", "2020/cat.jpg", nil)
+	actual, err := downloaded.ExtractContent()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, content, actual)
+
+	allPages, err := objects.List(objectStorage, "photos", objects.ListOpts{Full: true}).AllPages()
+	th.AssertNoErr(t, err)
+	info, err := objects.ExtractInfo(allPages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(info))
+	th.AssertEquals(t, "2020/cat.jpg", info[0].Name)
+	th.AssertEquals(t, int64(len(content)), info[0].Bytes)
+	th.AssertEquals(t, "image/jpeg", info[0].ContentType)
+
+	_, err = containers.Delete(objectStorage, "photos").Extract()
+	if e, ok := err.(gophercloud.ErrUnexpectedResponseCode); !ok || e.Actual != 409 {
+		t.Fatalf("Expected a 409 error, got %v", err)
+	}
+
+	_, err = objects.Delete(objectStorage, "photos", "2020/cat.jpg", nil).Extract()
+	th.AssertNoErr(t, err)
+	_, err = containers.Delete(objectStorage, "photos").Extract()
+	th.AssertNoErr(t, err)
+
+	allPages, err = containers.List(objectStorage, nil).AllPages()
+	th.AssertNoErr(t, err)
+	names, err := containers.ExtractNames(allPages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 0, len(names))
+}
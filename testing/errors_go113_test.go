@@ -0,0 +1,31 @@
+//go:build go1.13
+// +build go1.13
+
+package testing
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestErrorsIsStatusCode(t *testing.T) {
+	respErr := gophercloud.ErrUnexpectedResponseCode{
+		Actual:    http.StatusNotFound,
+		RequestID: "req-1234",
+	}
+	var err error = &gophercloud.ErrErrorAfterReauthentication{
+		ErrOriginal: gophercloud.ErrDefault404{ErrUnexpectedResponseCode: respErr},
+	}
+
+	th.AssertEquals(t, true, errors.Is(err, gophercloud.ErrDefault404{}))
+	th.AssertEquals(t, true, errors.Is(err, gophercloud.ErrUnexpectedResponseCode{Actual: http.StatusNotFound}))
+	th.AssertEquals(t, false, errors.Is(err, gophercloud.ErrDefault500{}))
+
+	var e gophercloud.ErrUnexpectedResponseCode
+	th.AssertEquals(t, true, errors.As(err, &e))
+	th.AssertEquals(t, "req-1234", e.RequestID)
+}
@@ -0,0 +1,116 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestParseFault(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		body     string
+		expected *gophercloud.Fault
+	}{
+		{
+			name: "nova",
+			body: `{"itemNotFound": {"code": 404, "message": "Instance 1234 could not be found."}}`,
+			expected: &gophercloud.Fault{
+				Type:    "itemNotFound",
+				Message: "Instance 1234 could not be found.",
+				Code:    404,
+			},
+		},
+		{
+			name: "cinder",
+			body: `{"badRequest": {"code": 400, "message": "Invalid input received: size must be positive."}}`,
+			expected: &gophercloud.Fault{
+				Type:    "badRequest",
+				Message: "Invalid input received: size must be positive.",
+				Code:    400,
+			},
+		},
+		{
+			name: "neutron",
+			body: `{"NeutronError": {"type": "NetworkInUse", "message": "Unable to complete operation on network 1234.", "detail": ""}}`,
+			expected: &gophercloud.Fault{
+				Type:    "NetworkInUse",
+				Message: "Unable to complete operation on network 1234.",
+			},
+		},
+		{
+			name: "keystone",
+			body: `{"error": {"code": 401, "message": "The request you have made requires authentication.", "title": "Unauthorized"}}`,
+			expected: &gophercloud.Fault{
+				Type:    "Unauthorized",
+				Message: "The request you have made requires authentication.",
+				Code:    401,
+			},
+		},
+		{
+			name: "octavia",
+			body: `{"faultcode": "Client", "faultstring": "Load Balancer 1234 not found.", "debuginfo": null}`,
+			expected: &gophercloud.Fault{
+				Type:    "Client",
+				Message: "Load Balancer 1234 not found.",
+			},
+		},
+		{
+			name: "heat",
+			body: `{"explanation": "The resource could not be found.", "code": 404, "error": {"message": "The Stack (web) could not be found.", "traceback": null, "type": "EntityNotFound"}, "title": "Not Found"}`,
+			expected: &gophercloud.Fault{
+				Type:    "EntityNotFound",
+				Message: "The Stack (web) could not be found.",
+				Details: "The resource could not be found.",
+				Code:    404,
+			},
+		},
+		{
+			name: "text",
+			body: `404 Not Found`,
+		},
+	} {
+		actual := gophercloud.ParseFault([]byte(tc.body))
+		if tc.expected == nil {
+			if actual != nil {
+				t.Errorf("%s: expected no fault, got %+v", tc.name, actual)
+			}
+			continue
+		}
+		if actual == nil {
+			t.Errorf("%s: expected %+v, got no fault", tc.name, tc.expected)
+			continue
+		}
+		th.CheckDeepEquals(t, *tc.expected, *actual)
+	}
+}
+
+func TestErrorFaultAndRequestID(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/servers/1234", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Compute-Request-Id", "req-5678")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"itemNotFound": {"code": 404, "message": "Instance 1234 could not be found."}}`)
+	})
+
+	client := new(gophercloud.ProviderClient)
+	_, err := client.Request("GET", th.Endpoint()+"servers/1234", &gophercloud.RequestOpts{})
+
+	e, ok := err.(gophercloud.ErrDefault404)
+	if !ok {
+		t.Fatalf("Expected an ErrDefault404 error, got %v", err)
+	}
+	th.AssertEquals(t, "req-5678", e.RequestID)
+	th.AssertEquals(t, "itemNotFound", e.Fault.Type)
+	th.AssertEquals(t, "Instance 1234 could not be found.", e.Fault.Message)
+
+	th.AssertEquals(t, true, e.Is(gophercloud.ErrDefault404{}))
+	th.AssertEquals(t, true, e.Is(gophercloud.ErrUnexpectedResponseCode{Actual: http.StatusNotFound}))
+	th.AssertEquals(t, false, e.Is(gophercloud.ErrDefault400{}))
+}
@@ -0,0 +1,109 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+type bufferLogger struct {
+	lines []string
+}
+
+func (l *bufferLogger) Printf(format string, v ...interface{}) {
+	l.lines = append(l.lines, fmt.Sprintf(format, v...))
+}
+
+type recordingHook struct {
+	infos  []gophercloud.RequestInfo
+	errors []error
+}
+
+func (h *recordingHook) BeforeRequest(info gophercloud.RequestInfo, req *http.Request) {
+	h.infos = append(h.infos, info)
+}
+
+func (h *recordingHook) AfterResponse(info gophercloud.RequestInfo, req *http.Request, resp *http.Response) {
+}
+
+func (h *recordingHook) OnReauth(info gophercloud.RequestInfo, err error) {}
+
+func (h *recordingHook) OnError(info gophercloud.RequestInfo, err error) {
+	h.errors = append(h.errors, err)
+}
+
+func TestRequestHooks(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	hook := new(recordingHook)
+	c := &gophercloud.ServiceClient{
+		ProviderClient: &gophercloud.ProviderClient{Hooks: []gophercloud.RequestHook{hook}},
+		Type:           "compute",
+		Microversion:   "2.60",
+	}
+	_, err := c.Get(th.Endpoint()+"route", nil, nil)
+	if _, ok := err.(gophercloud.ErrDefault404); !ok {
+		t.Fatalf("expected ErrDefault404, got %T: %v", err, err)
+	}
+
+	th.AssertEquals(t, 1, len(hook.infos))
+	th.AssertEquals(t, "compute", hook.infos[0].ServiceType)
+	th.AssertEquals(t, "2.60", hook.infos[0].Microversion)
+	th.AssertEquals(t, "GET", hook.infos[0].Method)
+	th.AssertEquals(t, 1, len(hook.errors))
+}
+
+func TestDebugLoggerRedacts(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	th.Mux.HandleFunc("/v3/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Subject-Token", "subject-token")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"token": {"methods": ["password"]}}`)
+	})
+
+	logger := new(bufferLogger)
+	p := &gophercloud.ProviderClient{
+		TokenID: "auth-token",
+		Hooks:   []gophercloud.RequestHook{&gophercloud.DebugLogger{Logger: logger}},
+	}
+
+	var actual struct {
+		Token struct {
+			Methods []string `json:"methods"`
+		} `json:"token"`
+	}
+	_, err := p.Request("POST", th.Endpoint()+"v3/auth/tokens", &gophercloud.RequestOpts{
+		JSONBody: map[string]interface{}{
+			"auth": map[string]interface{}{
+				"identity": map[string]interface{}{
+					"methods":  []string{"password"},
+					"password": map[string]interface{}{"user": map[string]interface{}{"name": "admin", "password": "hunter2"}},
+				},
+			},
+		},
+		JSONResponse: &actual,
+	})
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []string{"password"}, actual.Token.Methods)
+
+	th.AssertEquals(t, 2, len(logger.lines))
+	output := strings.Join(logger.lines, "\n")
+	for _, secret := range []string{"auth-token", "subject-token", "hunter2"} {
+		if strings.Contains(output, secret) {
+			t.Errorf("log output contains %q:\n%s", secret, output)
+		}
+	}
+	if !strings.Contains(output, `"name": "admin"`) {
+		t.Errorf("log output is missing the request body:\n%s", output)
+	}
+}
@@ -0,0 +1,89 @@
+package testing
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestURLTemplate(t *testing.T) {
+	for _, tc := range []struct {
+		serviceType, rawurl, expected string
+	}{
+		{"compute", "https://nova.example.com/v2.1/servers/1e4d4bd4-0d1a-4ee5-a1a8-4a9c7f3f4b5e/action", "/v2.1/servers/{id}/action"},
+		{"compute", "https://nova.example.com/v2.1/0123456789abcdef0123456789abcdef/servers?limit=10", "/v2.1/{id}/servers"},
+		{"compute", "https://nova.example.com/v2.1/servers/detail", "/v2.1/servers/detail"},
+		{"compute", "https://nova.example.com/v2.1/flavors/42/os-extra_specs/hw:cpu_policy", "/v2.1/flavors/{id}/os-extra_specs/{name}"},
+		{"compute", "https://nova.example.com/v2.1/flavors/detail", "/v2.1/flavors/detail"},
+		{"compute", "https://nova.example.com/v2.1/os-keypairs/my-laptop", "/v2.1/os-keypairs/{name}"},
+		{"orchestration", "https://heat.example.com/v1/0123456789abcdef0123456789abcdef/stacks/web/1e4d4bd4-0d1a-4ee5-a1a8-4a9c7f3f4b5e/resources/server", "/v1/{id}/stacks/{name}/{id}/resources/{name}"},
+		{"network", "https://neutron.example.com/v2.0/ports", "/v2.0/ports"},
+		{"object-store", "https://swift.example.com/v1/AUTH_0123/backups/2020/01/db.tar.gz", "/v1/{account}/{container}/{object}"},
+		{"object-store", "https://swift.example.com/v1/AUTH_0123/backups", "/v1/{account}/{container}"},
+		{"object-store", "https://swift.example.com/v1/AUTH_0123/", "/v1/{account}/"},
+		{"object-store", "https://swift.example.com/v1/myproject/backups/db.tar.gz", "/v1/{account}/{container}/{object}"},
+		{"object-store", "https://rgw.example.com/swift/v1/backups/2020/01/db.tar.gz", "/swift/v1/{account}/{container}/{object}"},
+		{"object-store", "https://swift.example.com/info", "/info"},
+		{"identity", "https://keystone.example.com/v3/users/0123456789abcdef0123456789abcdef/projects", "/v3/users/{id}/projects"},
+		{"identity", "https://keystone.example.com/v3/auth/tokens", "/v3/auth/tokens"},
+		{"identity", "https://keystone.example.com/v3/regions/RegionOne", "/v3/regions/{name}"},
+		{"load-balancer", "https://octavia.example.com/v2/lbaas/loadbalancers/0123456789abcdef0123456789abcdef", "/v2/lbaas/loadbalancers/{id}"},
+	} {
+		th.CheckEquals(t, tc.expected, gophercloud.URLTemplate(tc.serviceType, tc.rawurl))
+	}
+}
+
+type recordingObserver struct {
+	metrics []gophercloud.RequestMetrics
+}
+
+func (o *recordingObserver) ObserveRequest(m gophercloud.RequestMetrics) {
+	o.metrics = append(o.metrics, m)
+}
+
+func TestRequestMetrics(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	var reauthed bool
+	th.Mux.HandleFunc("/servers/1e4d4bd4-0d1a-4ee5-a1a8-4a9c7f3f4b5e", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Auth-Token") != client.TokenID {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	observer := new(recordingObserver)
+	c := client.ServiceClient()
+	c.Type = "compute"
+	c.ProviderClient.Metrics = observer
+	c.ProviderClient.SetToken("expired")
+	c.ProviderClient.ReauthFunc = func() error {
+		reauthed = true
+		c.ProviderClient.SetToken(client.TokenID)
+		return nil
+	}
+
+	_, err := c.Get(c.ServiceURL("servers", "1e4d4bd4-0d1a-4ee5-a1a8-4a9c7f3f4b5e"), nil, nil)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	th.AssertEquals(t, true, reauthed)
+
+	th.AssertEquals(t, 1, len(observer.metrics))
+	m := observer.metrics[0]
+	th.AssertEquals(t, "compute", m.ServiceType)
+	th.AssertEquals(t, "GET", m.Method)
+	th.AssertEquals(t, "/servers/{id}", m.URLTemplate)
+	th.AssertEquals(t, http.StatusNotFound, m.StatusCode)
+	th.AssertEquals(t, 0, m.Retries)
+	th.AssertEquals(t, 1, m.Reauths)
+	th.AssertEquals(t, err, m.Err)
+	if m.Duration <= 0 {
+		t.Errorf("expected a positive duration, got %s", m.Duration)
+	}
+}
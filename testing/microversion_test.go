@@ -0,0 +1,103 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestCompareMicroversions(t *testing.T) {
+	for _, tc := range []struct {
+		a, b     string
+		expected int
+	}{
+		{"2.1", "2.1", 0},
+		{"2.9", "2.10", -1},
+		{"2.60", "2.8", 1},
+		{"3.0", "2.90", 1},
+	} {
+		c, err := gophercloud.CompareMicroversions(tc.a, tc.b)
+		th.AssertNoErr(t, err)
+		switch {
+		case tc.expected < 0 && c >= 0, tc.expected > 0 && c <= 0, tc.expected == 0 && c != 0:
+			t.Errorf("Comparing %s to %s: expected %d, got %d", tc.a, tc.b, tc.expected, c)
+		}
+	}
+
+	_, err := gophercloud.CompareMicroversions("latest", "2.1")
+	if _, ok := err.(gophercloud.ErrInvalidInput); !ok {
+		t.Fatalf("Expected an ErrInvalidInput error, got %v", err)
+	}
+}
+
+func TestRequireMicroversion(t *testing.T) {
+	c := &gophercloud.ServiceClient{Type: "compute"}
+	th.AssertNoErr(t, c.RequireMicroversion("2.90"))
+
+	c.MinMicroversion = "2.1"
+	c.MaxMicroversion = "2.79"
+	c.Microversion = "2.79"
+	th.AssertNoErr(t, c.RequireMicroversion("2.79"))
+
+	err := c.RequireMicroversion("2.90")
+	th.AssertEquals(t,
+		"Microversion 2.90 is required, but the compute service only supports microversions 2.1 to 2.79",
+		err.Error())
+
+	c.Microversion = "latest"
+	th.AssertNoErr(t, c.RequireMicroversion("2.79"))
+}
+
+func TestRequireMicroversionNotRequested(t *testing.T) {
+	c := &gophercloud.ServiceClient{
+		Type:            "sharev2",
+		MinMicroversion: "2.0",
+		MaxMicroversion: "2.60",
+	}
+
+	// A client which requests no microversion relies on the default of the
+	// service.
+	th.AssertNoErr(t, c.RequireMicroversion("2.14"))
+
+	c.Microversion = "2.7"
+	err := c.RequireMicroversion("2.14")
+	e, ok := err.(gophercloud.ErrMicroversionUnsupported)
+	if !ok {
+		t.Fatalf("Expected an ErrMicroversionUnsupported error, got %v", err)
+	}
+	th.AssertEquals(t, true, e.NotRequested)
+	th.AssertEquals(t,
+		"Microversion 2.14 is required, but the sharev2 client requests microversion 2.7",
+		err.Error())
+
+	c.Microversion = "2.14"
+	th.AssertNoErr(t, c.RequireMicroversion("2.14"))
+
+	// The supported range is unknown.
+	c = &gophercloud.ServiceClient{Type: "sharev2", Microversion: "2.7"}
+	err = c.RequireMicroversion("2.14")
+	if _, ok := err.(gophercloud.ErrMicroversionUnsupported); !ok {
+		t.Fatalf("Expected an ErrMicroversionUnsupported error, got %v", err)
+	}
+}
+
+func TestVolumeMicroversionHeaders(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "OpenStack-API-Version", "volume 3.59")
+		th.TestHeader(t, r, "X-OpenStack-Volume-API-Version", "3.59")
+		w.WriteHeader(http.StatusOK)
+	})
+
+	c := &gophercloud.ServiceClient{
+		ProviderClient: new(gophercloud.ProviderClient),
+		Type:           "volumev3",
+		Microversion:   "3.59",
+	}
+	_, err := c.Get(fmt.Sprintf("%s/route", th.Endpoint()), nil, nil)
+	th.AssertNoErr(t, err)
+}
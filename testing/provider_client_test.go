@@ -1,9 +1,14 @@
 package testing
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -122,3 +127,319 @@ func TestConcurrentReauth(t *testing.T) {
 
 	th.AssertEquals(t, 1, info.numreauths)
 }
+
+func TestRequestWithContext(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, "OK")
+	}))
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	p := &gophercloud.ProviderClient{Context: ctx}
+
+	res, err := p.Request("GET", ts.URL, &gophercloud.RequestOpts{})
+	th.AssertNoErr(t, err)
+	_, err = ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	th.AssertNoErr(t, err)
+
+	cancel()
+	res, err = p.Request("GET", ts.URL, &gophercloud.RequestOpts{})
+	if err == nil {
+		t.Fatal("expecting error, got nil")
+	}
+	if !strings.Contains(err.Error(), ctx.Err().Error()) {
+		t.Fatalf("expecting error to contain: %q, got %q", ctx.Err().Error(), err.Error())
+	}
+
+	p.Context = nil
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	_, err = p.Request("GET", ts.URL, &gophercloud.RequestOpts{Context: ctx})
+	if err == nil {
+		t.Fatal("expecting error, got nil")
+	}
+}
+
+func TestRequestRetry(t *testing.T) {
+	var count int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count++
+		if count < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		th.CheckEquals(t, "payload", string(body))
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer ts.Close()
+
+	p := &gophercloud.ProviderClient{
+		RetryPolicy: &gophercloud.RetryPolicy{
+			MaxAttempts: 3,
+			BaseDelay:   time.Millisecond,
+		},
+	}
+
+	_, err := p.Request("PUT", ts.URL, &gophercloud.RequestOpts{
+		RawBody: strings.NewReader("payload"),
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 3, count)
+}
+
+func TestRequestRetryExhausted(t *testing.T) {
+	var count int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count++
+		w.WriteHeader(429)
+	}))
+	defer ts.Close()
+
+	p := &gophercloud.ProviderClient{
+		RetryPolicy: &gophercloud.RetryPolicy{
+			MaxAttempts: 2,
+			BaseDelay:   time.Millisecond,
+		},
+	}
+
+	_, err := p.Request("GET", ts.URL, &gophercloud.RequestOpts{})
+	if _, ok := err.(gophercloud.ErrDefault429); !ok {
+		t.Fatalf("expected ErrDefault429, got %T: %v", err, err)
+	}
+	th.AssertEquals(t, 2, count)
+}
+
+func TestRequestRetryNotIdempotent(t *testing.T) {
+	var count int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer ts.Close()
+
+	p := &gophercloud.ProviderClient{
+		RetryPolicy: &gophercloud.RetryPolicy{
+			BaseDelay: time.Millisecond,
+		},
+	}
+
+	_, err := p.Request("POST", ts.URL, &gophercloud.RequestOpts{})
+	if _, ok := err.(gophercloud.ErrDefault503); !ok {
+		t.Fatalf("expected ErrDefault503, got %T: %v", err, err)
+	}
+	th.AssertEquals(t, 1, count)
+}
+
+type unseekableReader struct {
+	*strings.Reader
+}
+
+func (r unseekableReader) Seek(offset int64, whence int) (int64, error) {
+	return 0, fmt.Errorf("cannot seek")
+}
+
+func TestRequestRetrySeekError(t *testing.T) {
+	var count int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer ts.Close()
+
+	p := &gophercloud.ProviderClient{
+		RetryPolicy: &gophercloud.RetryPolicy{
+			BaseDelay: time.Millisecond,
+		},
+	}
+
+	_, err := p.Request("PUT", ts.URL, &gophercloud.RequestOpts{
+		RawBody: unseekableReader{strings.NewReader("payload")},
+	})
+	if err == nil || err.Error() != "cannot seek" {
+		t.Fatalf("expected seek error, got %v", err)
+	}
+	th.AssertEquals(t, 1, count)
+}
+
+type fakeAuthResult struct {
+	tokenID   string
+	expiresAt time.Time
+}
+
+func (r fakeAuthResult) ExtractTokenID() (string, error) {
+	return r.tokenID, nil
+}
+
+func (r fakeAuthResult) ExtractTokenExpiresAt() (time.Time, error) {
+	return r.expiresAt, nil
+}
+
+func TestTokenRefreshBeforeExpiry(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", "new-token")
+		w.WriteHeader(http.StatusOK)
+	})
+
+	p := new(gophercloud.ProviderClient)
+	p.UseTokenLock()
+	p.TokenRefreshMargin = time.Minute
+	err := p.SetTokenAndAuthResult(fakeAuthResult{tokenID: "old-token", expiresAt: time.Now().Add(30 * time.Second)})
+	th.AssertNoErr(t, err)
+
+	newResult := fakeAuthResult{tokenID: "new-token", expiresAt: time.Now().Add(time.Hour)}
+	reauthCount := 0
+	p.ReauthFunc = func() error {
+		reauthCount++
+		tac := new(gophercloud.ProviderClient)
+		if err := tac.SetTokenAndAuthResult(newResult); err != nil {
+			return err
+		}
+		p.CopyTokenFrom(tac)
+		return nil
+	}
+
+	for i := 0; i < 2; i++ {
+		_, err = p.Request("GET", th.Endpoint()+"route", &gophercloud.RequestOpts{})
+		th.AssertNoErr(t, err)
+	}
+
+	th.AssertEquals(t, 1, reauthCount)
+	th.AssertEquals(t, "new-token", p.Token())
+	th.AssertEquals(t, newResult.expiresAt, p.TokenExpiresAt())
+	th.AssertEquals(t, newResult, p.GetAuthResult())
+}
+
+func TestFileTokenCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gophercloud")
+	th.AssertNoErr(t, err)
+	defer os.RemoveAll(dir)
+
+	cache := gophercloud.FileTokenCache{Dir: filepath.Join(dir, "tokens")}
+
+	token, err := cache.Load("key")
+	th.AssertNoErr(t, err)
+	if token != nil {
+		t.Fatalf("expected no token, got %+v", token)
+	}
+
+	expected := &gophercloud.CachedToken{
+		ID:        "0123456789",
+		ExpiresAt: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+		Body:      []byte(`{"token":{"methods":["password"]}}`),
+	}
+	th.AssertNoErr(t, cache.Store("key", expected))
+
+	fi, err := os.Stat(filepath.Join(dir, "tokens", "key.json"))
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, os.FileMode(0600), fi.Mode().Perm())
+
+	token, err = cache.Load("key")
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, expected, token)
+}
+
+func TestRateLimiter(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	p := &gophercloud.ProviderClient{
+		RateLimiter: &gophercloud.RateLimiter{
+			Limits: map[gophercloud.RateLimitKey]gophercloud.RateLimit{
+				{ServiceType: "compute", Method: "DELETE"}: {Rate: 20},
+			},
+		},
+	}
+	compute := &gophercloud.ServiceClient{ProviderClient: p, Endpoint: ts.URL + "/", Type: "compute"}
+	network := &gophercloud.ServiceClient{ProviderClient: p, Endpoint: ts.URL + "/", Type: "network"}
+
+	// Only compute DELETE requests are limited, to one every 50ms.
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		_, err := compute.Get(compute.ServiceURL("servers"), nil, &gophercloud.RequestOpts{OkCodes: []int{204}})
+		th.AssertNoErr(t, err)
+		_, err = network.Delete(network.ServiceURL("ports", "1234"), nil)
+		th.AssertNoErr(t, err)
+	}
+	if elapsed := time.Since(start); elapsed > 150*time.Millisecond {
+		t.Fatalf("unlimited requests took %s", elapsed)
+	}
+
+	start = time.Now()
+	for i := 0; i < 5; i++ {
+		_, err := compute.Delete(compute.ServiceURL("servers", "1234"), nil)
+		th.AssertNoErr(t, err)
+	}
+	if elapsed := time.Since(start); elapsed < 190*time.Millisecond {
+		t.Fatalf("5 requests at 20 per second took %s", elapsed)
+	}
+}
+
+func TestRateLimiterContext(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	p := &gophercloud.ProviderClient{
+		RateLimiter: &gophercloud.RateLimiter{
+			Limits: map[gophercloud.RateLimitKey]gophercloud.RateLimit{
+				{}: {Rate: 0.1},
+			},
+		},
+	}
+
+	_, err := p.Request("GET", ts.URL, &gophercloud.RequestOpts{OkCodes: []int{204}})
+	th.AssertNoErr(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = p.Request("GET", ts.URL, &gophercloud.RequestOpts{Context: ctx, OkCodes: []int{204}})
+	th.AssertEquals(t, context.DeadlineExceeded, err)
+}
+
+func TestRateLimiterMaxConcurrent(t *testing.T) {
+	var mu sync.Mutex
+	var inFlight, maxInFlight int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mu.Unlock()
+
+		time.Sleep(10 * time.Millisecond)
+
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	p := &gophercloud.ProviderClient{
+		RateLimiter: &gophercloud.RateLimiter{MaxConcurrent: 2},
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := p.Request("GET", ts.URL, &gophercloud.RequestOpts{OkCodes: []int{204}})
+			th.CheckNoErr(t, err)
+		}()
+	}
+	wg.Wait()
+
+	if maxInFlight < 1 || maxInFlight > 2 {
+		t.Fatalf("expected at most 2 requests in flight, got %d", maxInFlight)
+	}
+}
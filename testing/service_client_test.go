@@ -32,3 +32,39 @@ func TestMoreHeaders(t *testing.T) {
 	th.AssertNoErr(t, err)
 	th.AssertEquals(t, resp.Request.Header.Get("custom"), "header")
 }
+
+func TestGlobalRequestID(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	var received []string
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		received = append(received, r.Header.Get("X-OpenStack-Request-ID"))
+		w.Header().Set("X-Openstack-Request-Id", "req-local")
+		w.WriteHeader(http.StatusOK)
+	})
+
+	provider := new(gophercloud.ProviderClient)
+	provider.GlobalRequestID = gophercloud.NewGlobalRequestID()
+	c := &gophercloud.ServiceClient{ProviderClient: provider}
+	url := fmt.Sprintf("%s/route", th.Endpoint())
+
+	var r gophercloud.Result
+	resp, err := c.Get(url, nil, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	th.AssertNoErr(t, r.Err)
+	th.AssertEquals(t, "req-local", r.RequestID())
+
+	_, err = c.WithGlobalRequestID("req-call").Get(url, nil, nil)
+	th.AssertNoErr(t, err)
+
+	c.GlobalRequestID = "req-service"
+	_, err = c.Get(url, nil, nil)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 3, len(received))
+	th.AssertEquals(t, provider.GlobalRequestID, received[0])
+	th.AssertEquals(t, "req-call", received[1])
+	th.AssertEquals(t, "req-service", received[2])
+	th.AssertEquals(t, 40, len(provider.GlobalRequestID))
+}
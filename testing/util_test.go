@@ -1,6 +1,7 @@
 package testing
 
 import (
+	"context"
 	"errors"
 	"os"
 	"path/filepath"
@@ -53,6 +54,24 @@ func TestWaitForPredicateExceed(t *testing.T) {
 	th.AssertEquals(t, "A timeout occurred", err.Error())
 }
 
+func TestWaitForContext(t *testing.T) {
+	err := gophercloud.WaitForContext(context.Background(), func() (bool, error) {
+		return true, nil
+	})
+	th.CheckNoErr(t, err)
+}
+
+func TestWaitForContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := gophercloud.WaitForContext(ctx, func() (bool, error) {
+		t.Error("predicate should not be called")
+		return true, nil
+	})
+	th.AssertEquals(t, context.Canceled, err)
+}
+
 func TestNormalizeURL(t *testing.T) {
 	urls := []string{
 		"NoSlashAtEnd",
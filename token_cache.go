@@ -0,0 +1,94 @@
+package gophercloud
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// CachedToken is a token saved in a TokenCache.
+type CachedToken struct {
+	// ID is the token itself.
+	ID string `json:"id"`
+
+	// ExpiresAt is the timestamp at which the token will no longer be
+	// accepted.
+	ExpiresAt time.Time `json:"expires_at"`
+
+	// Body is the body of the authentication response which issued the token.
+	// It holds the service catalog.
+	Body json.RawMessage `json:"body"`
+}
+
+// TokenCache persists tokens so that they can be reused by other
+// ProviderClients, typically in later runs of a short-lived program. Keys are
+// derived from the authentication options and never contain credentials.
+type TokenCache interface {
+	// Load returns the token saved under key, or nil if there is none.
+	Load(key string) (*CachedToken, error)
+
+	// Store saves token under key, replacing any previous token.
+	Store(key string, token *CachedToken) error
+}
+
+// FileTokenCache is a TokenCache which saves each token in its own file in
+// Dir. The directory and files are only accessible to the current user.
+//
+//	provider.TokenCache = gophercloud.FileTokenCache{
+//		Dir: filepath.Join(os.Getenv("HOME"), ".cache", "gophercloud"),
+//	}
+type FileTokenCache struct {
+	Dir string
+}
+
+func (c FileTokenCache) path(key string) string {
+	return filepath.Join(c.Dir, key+".json")
+}
+
+// Load implements TokenCache.
+func (c FileTokenCache) Load(key string) (*CachedToken, error) {
+	b, err := ioutil.ReadFile(c.path(key))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var token CachedToken
+	if err := json.Unmarshal(b, &token); err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// Store implements TokenCache. The file is replaced atomically, so that
+// concurrent processes never read a partial token.
+func (c FileTokenCache) Store(key string, token *CachedToken) error {
+	b, err := json.Marshal(token)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(c.Dir, 0700); err != nil {
+		return err
+	}
+
+	f, err := ioutil.TempFile(c.Dir, key+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), c.path(key))
+}
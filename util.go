@@ -1,6 +1,8 @@
 package gophercloud
 
 import (
+	"context"
+	"crypto/rand"
 	"fmt"
 	"net/url"
 	"path/filepath"
@@ -15,41 +17,54 @@ import (
 // Resource packages will wrap this in a more convenient function that's
 // specific to a certain resource, but it can also be useful on its own.
 func WaitFor(timeout int, predicate func() (bool, error)) error {
+	ctx := context.Background()
+	if timeout >= 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
+		defer cancel()
+	}
+
+	err := WaitForContext(ctx, predicate)
+	if err == context.DeadlineExceeded {
+		return fmt.Errorf("A timeout occurred")
+	}
+	return err
+}
+
+// WaitForContext polls a predicate function, once per second, until it
+// returns true, returns an error, or the given context is done. In the last
+// case the context's error is returned. A predicate that is still running when
+// the context is done is abandoned.
+func WaitForContext(ctx context.Context, predicate func() (bool, error)) error {
 	type WaitForResult struct {
 		Success bool
 		Error   error
 	}
 
-	start := time.Now().Unix()
-
 	for {
-		// If a timeout is set, and that's been exceeded, shut it down.
-		if timeout >= 0 && time.Now().Unix()-start >= int64(timeout) {
-			return fmt.Errorf("A timeout occurred")
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(1 * time.Second):
 		}
 
-		time.Sleep(1 * time.Second)
-
-		var result WaitForResult
-		ch := make(chan bool, 1)
+		ch := make(chan WaitForResult, 1)
 		go func() {
-			defer close(ch)
 			satisfied, err := predicate()
-			result.Success = satisfied
-			result.Error = err
+			ch <- WaitForResult{Success: satisfied, Error: err}
 		}()
 
 		select {
-		case <-ch:
+		case result := <-ch:
 			if result.Error != nil {
 				return result.Error
 			}
 			if result.Success {
 				return nil
 			}
-		// If the predicate has not finished by the timeout, cancel it.
-		case <-time.After(time.Duration(timeout) * time.Second):
-			return fmt.Errorf("A timeout occurred")
+		// If the predicate has not finished before the context is done, cancel it.
+		case <-ctx.Done():
+			return ctx.Err()
 		}
 	}
 }
@@ -100,3 +115,14 @@ func NormalizePathURL(basePath, rawPath string) (string, error) {
 	return u.String(), nil
 
 }
+
+// NewGlobalRequestID returns a random global request ID, in the
+// "req-<UUID>" format required by OpenStack services.
+func NewGlobalRequestID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	// Make it a version 4, variant 1 UUID.
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("req-%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}